@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Mirror repository
+resource "forgejo_repository" "mirror" {
+  name       = "mirror_test_repo"
+  clone_addr = "https://github.com/svalabs/terraform-provider-forgejo"
+  mirror     = true
+}
+
+# Mirror synchronization
+action "forgejo_repository_mirror_sync" "sync" {
+  config {
+    owner        = forgejo_repository.mirror.owner
+    name         = forgejo_repository.mirror.name
+    wait         = true
+    wait_timeout = 600
+  }
+}
+
+# Synchronize mirror whenever the source address changes
+resource "terraform_data" "mirror_source" {
+  input = forgejo_repository.mirror.clone_addr
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.forgejo_repository_mirror_sync.sync]
+    }
+  }
+}
@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Organization
+resource "forgejo_organization" "owner" {
+  name = "test_org"
+}
+
+# Repository
+resource "forgejo_repository" "repo" {
+  name = "test_repo"
+}
+
+# Runner registration token of the authenticated user
+data "forgejo_actions_runner_registration_token" "user" {
+  scope = "user"
+}
+
+# Runner registration token of an organization
+data "forgejo_actions_runner_registration_token" "organization" {
+  scope           = "organization"
+  organization_id = forgejo_organization.owner.id
+}
+
+# Runner registration token of a repository
+data "forgejo_actions_runner_registration_token" "repository" {
+  scope         = "repository"
+  repository_id = forgejo_repository.repo.id
+}
@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Existing repository
+data "forgejo_repository" "test" {
+  owner = "test_user"
+  name = "test_repo"
+}
+
+# Branch protection of the main branch
+data "forgejo_branch_protection" "main" {
+  repository_id = data.forgejo_repository.test.id
+  branch_name   = "main"
+}
+
+output "main_required_approvals" {
+  value = data.forgejo_branch_protection.main.required_approvals
+}
@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# All repositories of an existing organization
+data "forgejo_repositories" "production" {
+  owner = "production"
+}
+
+# Branch protections of every repository
+data "forgejo_branch_protections" "protections" {
+  for_each = { for r in data.forgejo_repositories.production.repositories : r.full_name => r }
+
+  repository_id = each.value.id
+}
+
+# Every repository must protect its default branch with at least two approvals
+check "required_approvals" {
+  assert {
+    condition = alltrue([
+      for r in data.forgejo_repositories.production.repositories : anytrue([
+        for bp in data.forgejo_branch_protections.protections[r.full_name].branch_protections :
+        bp.branch_name == r.default_branch && bp.required_approvals >= 2
+      ])
+    ])
+    error_message = "Every production repository needs a branch protection on its default branch requiring at least 2 approvals."
+  }
+}
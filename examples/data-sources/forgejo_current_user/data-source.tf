@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+variable "organization" {
+  description = "Organization owning the repository; defaults to the authenticated user"
+  type        = string
+  default     = null
+}
+
+# User the provider is authenticated as
+data "forgejo_current_user" "me" {}
+
+# Repository owned by the organization if given, otherwise by the caller
+resource "forgejo_repository" "example" {
+  owner = coalesce(var.organization, data.forgejo_current_user.me.login)
+  name  = "example"
+}
+
+output "is_admin" {
+  value = data.forgejo_current_user.me.admin
+}
@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Action secrets of an existing organization (by name)
+data "forgejo_organization_action_secrets" "by_name" {
+  organization = "test_org"
+}
+
+# Action secrets of an existing organization (by ID)
+data "forgejo_organization_action_secrets" "by_id" {
+  organization_id = 1
+}
+
+output "secret_names" {
+  value = data.forgejo_organization_action_secrets.by_name.secrets[*].name
+}
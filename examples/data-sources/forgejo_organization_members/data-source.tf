@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Members of an existing organization
+data "forgejo_organization_members" "members" {
+  organization = "test_organization"
+}
+
+# Logins of all public members
+output "public_members" {
+  value = [for m in data.forgejo_organization_members.members.members : m.login if m.public]
+}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# All organizations
+data "forgejo_organizations" "all" {}
+
+# Private organizations
+data "forgejo_organizations" "private" {
+  visibility = "private"
+}
+
+# Teams of every organization
+data "forgejo_teams" "teams" {
+  for_each = { for o in data.forgejo_organizations.all.organizations : o.name => o }
+
+  organization_id = each.value.id
+}
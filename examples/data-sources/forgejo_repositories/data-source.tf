@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# All repositories of an existing organization
+data "forgejo_repositories" "org" {
+  owner = "test_org"
+}
+
+# Non-archived repositories tagged with a topic
+data "forgejo_repositories" "topic" {
+  topic    = "terraform"
+  archived = false
+}
+
+# Protect the default branch of every repository tagged with the topic
+resource "forgejo_branch_protection" "main" {
+  for_each = { for r in data.forgejo_repositories.topic.repositories : r.full_name => r }
+
+  repository_id      = each.value.id
+  branch_name        = each.value.default_branch
+  required_approvals = 1
+}
@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+locals {
+  required_secrets = ["DEPLOY_TOKEN", "REGISTRY_PASSWORD"]
+}
+
+# All repositories of an existing organization
+data "forgejo_repositories" "org" {
+  owner = "test_org"
+}
+
+# Action secrets of every repository
+data "forgejo_repository_action_secrets" "secrets" {
+  for_each = { for r in data.forgejo_repositories.org.repositories : r.full_name => r }
+
+  repository_id = each.value.id
+}
+
+# Required secrets missing from each repository
+output "missing_secrets" {
+  value = {
+    for name, s in data.forgejo_repository_action_secrets.secrets :
+    name => setsubtract(local.required_secrets, s.secrets[*].name)
+    if length(setsubtract(local.required_secrets, s.secrets[*].name)) > 0
+  }
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Existing repository
+data "forgejo_repository" "repo" {
+  owner = "test_user"
+  name  = "user_test_repo"
+}
+
+# Existing repository webhook
+data "forgejo_repository_webhook" "webhook" {
+  repository_id = data.forgejo_repository.repo.id
+  webhook_id    = 1
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# All repositories of an existing organization
+data "forgejo_repositories" "org" {
+  owner = "test_org"
+}
+
+# Webhooks of every repository
+data "forgejo_repository_webhooks" "webhooks" {
+  for_each = { for r in data.forgejo_repositories.org.repositories : r.full_name => r }
+
+  repository_id = each.value.id
+}
+
+# Repositories without a webhook pointing at the CI server
+output "repositories_without_ci" {
+  value = [
+    for name, hooks in data.forgejo_repository_webhooks.webhooks : name
+    if !anytrue([for h in hooks.webhooks : startswith(lookup(h.config, "url", ""), "https://ci.example.com/")])
+  ]
+}
@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Settings of the Forgejo instance
+data "forgejo_server_settings" "this" {}
+
+# Existing repository
+data "forgejo_repository" "test" {
+  owner = "test_user"
+  name  = "test_repo"
+}
+
+# Only output the HTTP(S) clone URL if the instance allows Git over HTTP(S)
+output "clone_url" {
+  value = data.forgejo_server_settings.this.repository.http_git_disabled ? null : data.forgejo_repository.test.clone_url
+}
+
+output "max_attachment_size_mb" {
+  value = data.forgejo_server_settings.this.attachment.enabled ? data.forgejo_server_settings.this.attachment.max_size : 0
+}
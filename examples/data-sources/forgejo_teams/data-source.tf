@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Teams of an existing organization
+data "forgejo_teams" "teams" {
+  organization = "test_organization"
+}
+
+# Names of all teams with write access to code
+output "code_writers" {
+  value = [for t in data.forgejo_teams.teams.teams : t.name if t.units.code == "write"]
+}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# All users
+data "forgejo_users" "all" {}
+
+# Active local administrators
+data "forgejo_users" "admins" {
+  source_id = 0
+  active    = true
+  admin     = true
+}
+
+# Logins of all restricted users
+output "restricted_users" {
+  value = [for u in data.forgejo_users.all.users : u.login if u.restricted]
+}
@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+    vault = {
+      source = "hashicorp/vault"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Organization
+resource "forgejo_organization" "owner" {
+  name = "test_org"
+}
+
+# Runner registration token of an organization (never stored in state)
+ephemeral "forgejo_actions_runner_registration_token" "organization" {
+  scope           = "organization"
+  organization_id = forgejo_organization.owner.id
+}
+
+# Pass the token to a write-only attribute, e.g. of a secret store
+resource "vault_kv_secret_v2" "runner" {
+  mount                = "secret"
+  name                 = "forgejo-runner"
+  data_json_wo         = jsonencode({ token = ephemeral.forgejo_actions_runner_registration_token.organization.token })
+  data_json_wo_version = 1
+}
@@ -0,0 +1,2 @@
+# Import using the numeric OAuth2 application ID.
+terraform import forgejo_oauth2_application.web_app 1
@@ -0,0 +1,46 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Confidential client (e.g. a server-side web application)
+resource "forgejo_oauth2_application" "web_app" {
+  name = "Internal web application"
+  redirect_uris = [
+    "https://app.example.com/oauth2/callback",
+  ]
+}
+
+# Public client (e.g. a native or single-page application)
+resource "forgejo_oauth2_application" "cli_app" {
+  name                = "Internal CLI"
+  confidential_client = false
+  redirect_uris = [
+    "http://127.0.0.1:8080/callback",
+  ]
+}
+
+# Regenerate the client secret by changing the trigger value
+resource "forgejo_oauth2_application" "rotated" {
+  name                  = "Rotated application"
+  client_secret_trigger = "2026-10"
+  redirect_uris = [
+    "https://rotated.example.com/oauth2/callback",
+  ]
+}
+
+output "web_app_client_id" {
+  value = forgejo_oauth2_application.web_app.client_id
+}
+
+output "web_app_client_secret" {
+  value     = forgejo_oauth2_application.web_app.client_secret
+  sensitive = true
+}
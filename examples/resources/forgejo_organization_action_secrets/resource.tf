@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Organization
+resource "forgejo_organization" "test" {
+  name = "test_org"
+}
+
+# Organization action secrets
+resource "forgejo_organization_action_secrets" "this" {
+  organization_id  = forgejo_organization.test.id
+  delete_unmanaged = true
+  secrets = {
+    my_secret       = { data = "my_secret_value" }
+    my_other_secret = { data = "my_other_secret_value" }
+  }
+}
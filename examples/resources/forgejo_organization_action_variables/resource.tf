@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Organization
+resource "forgejo_organization" "test" {
+  name = "test_org"
+}
+
+# Organization action variables
+resource "forgejo_organization_action_variables" "this" {
+  organization_id  = forgejo_organization.test.id
+  delete_unmanaged = true
+  variables = {
+    my_variable       = "my_variable_value"
+    my_other_variable = "my_other_variable_value"
+  }
+}
@@ -0,0 +1,2 @@
+# Import using the org_name/user_name.
+terraform import forgejo_organization_member.imported test_org/test_user
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Organization
+resource "forgejo_organization" "owner" {
+  name = "test_org"
+}
+
+# Existing organization member, removed from the organization and all of its
+# teams on destroy
+resource "forgejo_organization_member" "offboarding" {
+  organization = forgejo_organization.owner.name
+  user         = "leaving_user"
+}
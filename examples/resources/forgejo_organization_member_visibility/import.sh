@@ -0,0 +1,2 @@
+# Import using the org_name (for the authenticated user).
+terraform import forgejo_organization_member_visibility.imported test_org
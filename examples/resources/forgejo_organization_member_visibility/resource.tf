@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Organization (the authenticated user becomes a member as its creator)
+resource "forgejo_organization" "owner" {
+  name = "test_org"
+}
+
+# Public organization membership of the authenticated user
+resource "forgejo_organization_member_visibility" "public" {
+  organization = forgejo_organization.owner.name
+  visibility   = "public"
+}
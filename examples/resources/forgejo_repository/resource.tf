@@ -127,6 +127,58 @@ resource "forgejo_repository" "mirror" {
   mirror_interval = "12h0m0s" # optional
 }
 
+# Personal fork
+# (owned by the authenticated user)
+resource "forgejo_repository" "personal_fork" {
+  name = "personal_test_repo_fork"
+
+  fork_from = {
+    owner      = forgejo_organization.owner.name
+    repository = forgejo_repository.org_defaults.name
+  }
+}
+
+# Organization fork
+resource "forgejo_repository" "org_fork" {
+  owner = forgejo_organization.owner.name
+  name  = "org_test_repo_fork"
+
+  fork_from = {
+    owner      = forgejo_user.owner.login
+    repository = forgejo_repository.user_defaults.name
+  }
+}
+
+# Template repository
+resource "forgejo_repository" "template" {
+  name     = "template_test_repo"
+  template = true
+}
+
+# Repository generated from template
+resource "forgejo_repository" "generated" {
+  owner = forgejo_organization.owner.name
+  name  = "generated_test_repo"
+
+  from_template = {
+    owner              = forgejo_repository.template.owner
+    repository         = forgejo_repository.template.name
+    git_content        = true
+    topics             = true
+    labels             = true
+    webhooks           = false
+    avatar             = true
+    git_hooks          = false
+    protected_branches = true
+  }
+}
+
+# Repository protected from accidental deletion
+resource "forgejo_repository" "protected" {
+  name                         = "protected_test_repo"
+  prevent_destroy_if_not_empty = true
+}
+
 # Import repository
 resource "forgejo_repository" "imported" {
   owner = forgejo_user.owner.login
@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Personal repository
+resource "forgejo_repository" "personal" {
+  name = "personal_test_repo"
+}
+
+# Repository action secrets
+resource "forgejo_repository_action_secrets" "this" {
+  repository_id = forgejo_repository.personal.id
+  secrets = {
+    my_secret       = { data = "my_secret_value" }
+    my_other_secret = { data = "my_other_secret_value" }
+  }
+}
@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Personal repository
+resource "forgejo_repository" "personal" {
+  name = "personal_test_repo"
+}
+
+# Repository action variables
+resource "forgejo_repository_action_variables" "this" {
+  repository_id = forgejo_repository.personal.id
+  variables = {
+    my_variable       = "my_variable_value"
+    my_other_variable = "my_other_variable_value"
+  }
+}
@@ -20,8 +20,8 @@ resource "forgejo_team" "default_team" {
   organization_id = forgejo_organization.owner.id
   name            = "org_test_team_defaults"
 
-  units_map = {
-    "repo.code" = "read"
+  units = {
+    code = "read"
   }
 }
 
@@ -34,10 +34,10 @@ resource "forgejo_team" "custom_team" {
   includes_all_repositories = true
   permission                = "read"
 
-  units_map = {
-    "repo.code"   = "read"
-    "repo.issues" = "write"
-    "repo.pulls"  = "read"
+  units = {
+    code   = "read"
+    issues = "write"
+    pulls  = "read"
   }
 }
 
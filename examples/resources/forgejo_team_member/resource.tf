@@ -22,8 +22,8 @@ resource "forgejo_team" "team" {
   organization_id = forgejo_organization.owner.id
   name            = "org_test_team"
 
-  units_map = {
-    "repo.code" = "read"
+  units = {
+    code = "read"
   }
 }
 
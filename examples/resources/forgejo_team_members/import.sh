@@ -0,0 +1,2 @@
+# Import using the org_name/team_name.
+terraform import forgejo_team_members.members test_org/org_test_team
@@ -0,0 +1,50 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+variable "test_password" { sensitive = true }
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Organization
+resource "forgejo_organization" "owner" {
+  name = "test_org"
+}
+
+# Team
+resource "forgejo_team" "team" {
+  organization_id = forgejo_organization.owner.id
+  name            = "org_test_team"
+
+  units = {
+    code = "read"
+  }
+}
+
+# Users
+resource "forgejo_user" "alice" {
+  login    = "alice"
+  email    = "alice@localhost.localdomain"
+  password = var.test_password
+}
+
+resource "forgejo_user" "bob" {
+  login    = "bob"
+  email    = "bob@localhost.localdomain"
+  password = var.test_password
+}
+
+# Team members (authoritative)
+resource "forgejo_team_members" "members" {
+  team_id = forgejo_team.team.id
+  members = [
+    forgejo_user.alice.login,
+    forgejo_user.bob.login,
+  ]
+}
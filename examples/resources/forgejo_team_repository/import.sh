@@ -0,0 +1,2 @@
+# Import using the org_name/team_name/repo_name.
+terraform import forgejo_team_repository.imported test_org/org_test_team/repository-to-be-imported
@@ -0,0 +1,51 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Organization
+resource "forgejo_organization" "owner" {
+  name = "test_org"
+}
+
+# Team
+resource "forgejo_team" "team" {
+  organization_id           = forgejo_organization.owner.id
+  name                      = "org_test_team"
+  includes_all_repositories = false
+
+  units = {
+    code = "read"
+  }
+}
+
+# Repositories
+resource "forgejo_repository" "repo" {
+  owner = forgejo_organization.owner.name
+  name  = "org_test_repo"
+}
+
+resource "forgejo_repository" "another_repo" {
+  owner = forgejo_organization.owner.name
+  name  = "another_org_test_repo"
+}
+
+# Team repository (by repository ID)
+resource "forgejo_team_repository" "by_id" {
+  team_id       = forgejo_team.team.id
+  repository_id = forgejo_repository.repo.id
+}
+
+# Team repository (by owner and name)
+resource "forgejo_team_repository" "by_name" {
+  team_id = forgejo_team.team.id
+  owner   = forgejo_repository.another_repo.owner
+  name    = forgejo_repository.another_repo.name
+}
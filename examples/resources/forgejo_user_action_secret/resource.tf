@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# User action secret (of the authenticated user)
+resource "forgejo_user_action_secret" "this" {
+  name = "my_secret"
+  data = "my_secret_value"
+}
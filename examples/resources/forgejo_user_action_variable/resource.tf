@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# User action variable (of the authenticated user)
+resource "forgejo_user_action_variable" "this" {
+  name = "my_variable"
+  data = "my_variable_value"
+}
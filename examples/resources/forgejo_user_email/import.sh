@@ -0,0 +1,2 @@
+# Import using the email address.
+terraform import forgejo_user_email.secondary secondary@localhost.localdomain
@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    forgejo = {
+      source = "svalabs/forgejo"
+    }
+  }
+}
+
+provider "forgejo" {
+  host = "http://localhost:3000"
+}
+
+# Additional email address of the authenticated user
+resource "forgejo_user_email" "secondary" {
+  email = "secondary@localhost.localdomain"
+}
@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+const (
+	// actionsRunnerScope* are the supported scopes of runner registration tokens.
+	actionsRunnerScopeOrganization = "organization"
+	actionsRunnerScopeRepository   = "repository"
+	actionsRunnerScopeUser         = "user"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &actionsRunnerRegistrationTokenDataSource{}
+	_ datasource.DataSourceWithConfigure = &actionsRunnerRegistrationTokenDataSource{}
+)
+
+// actionsRunnerRegistrationTokenDataSource is the data source implementation.
+type actionsRunnerRegistrationTokenDataSource struct {
+	client *forgejo.Client
+}
+
+// actionsRunnerRegistrationTokenDataSourceModel maps the data source schema data.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#RunnerRegistrationToken
+type actionsRunnerRegistrationTokenDataSourceModel struct {
+	Scope          types.String `tfsdk:"scope"`
+	OrganizationID types.Int64  `tfsdk:"organization_id"`
+	RepositoryID   types.Int64  `tfsdk:"repository_id"`
+	Token          types.String `tfsdk:"token"`
+}
+
+// Metadata returns the data source type name.
+func (d *actionsRunnerRegistrationTokenDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_actions_runner_registration_token"
+}
+
+// Schema defines the schema for the data source.
+func (d *actionsRunnerRegistrationTokenDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo Actions runner registration token data source.
+
+Returns a token to register Forgejo Actions runners for an organization, a repository or the authenticated user, depending on ` + "`scope`" + `.
+
+**Note**: The token is stored in the Terraform state. Use the ` + "`forgejo_actions_runner_registration_token`" + ` ephemeral resource to avoid this.`,
+
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Scope of the registration token. Use `organization` with `organization_id`, `repository` with `repository_id`, or `user` for the authenticated user. **Note**: Instance-wide registration tokens are not available, as the Forgejo SDK does not expose them.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						actionsRunnerScopeOrganization,
+						actionsRunnerScopeRepository,
+						actionsRunnerScopeUser,
+					),
+				},
+			},
+			"organization_id": schema.Int64Attribute{
+				MarkdownDescription: "Numeric identifier of the organization. Required for scope `organization`. **Note**: Conflicts with `repository_id`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.Expressions{
+						path.MatchRoot("repository_id"),
+					}...),
+				},
+			},
+			"repository_id": schema.Int64Attribute{
+				MarkdownDescription: "Numeric identifier of the repository. Required for scope `repository`. **Note**: Conflicts with `organization_id`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.Expressions{
+						path.MatchRoot("organization_id"),
+					}...),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "Runner registration token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *actionsRunnerRegistrationTokenDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *actionsRunnerRegistrationTokenDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer un(trace(ctx, "Read actions runner registration token data source"))
+
+	var data actionsRunnerRegistrationTokenDataSourceModel
+
+	// Read Terraform configuration data into model
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get runner registration token
+	token, diags := getActionsRunnerRegistrationToken(
+		ctx,
+		d.client,
+		data.Scope.ValueString(),
+		data.OrganizationID,
+		data.RepositoryID,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	data.Token = types.StringValue(token.Token)
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewActionsRunnerRegistrationTokenDataSource is a helper function to simplify the provider implementation.
+func NewActionsRunnerRegistrationTokenDataSource() datasource.DataSource {
+	return &actionsRunnerRegistrationTokenDataSource{}
+}
+
+// getActionsRunnerRegistrationToken is a helper function to fetch a runner
+// registration token for an organization, a repository or the authenticated
+// user, depending on the given scope.
+func getActionsRunnerRegistrationToken(ctx context.Context, client *forgejo.Client, scope string, organizationID, repositoryID types.Int64) (*forgejo.RunnerRegistrationToken, diag.Diagnostics) {
+	var (
+		diags  diag.Diagnostics
+		token  *forgejo.RunnerRegistrationToken
+		res    *forgejo.Response
+		err    error
+		target string
+	)
+
+	// Check identifiers required by scope
+	switch {
+	case scope == actionsRunnerScopeOrganization && organizationID.IsNull():
+		diags.AddError(
+			"Unable to get runner registration token",
+			"Attribute organization_id is required for scope 'organization'",
+		)
+	case scope == actionsRunnerScopeRepository && repositoryID.IsNull():
+		diags.AddError(
+			"Unable to get runner registration token",
+			"Attribute repository_id is required for scope 'repository'",
+		)
+	case scope != actionsRunnerScopeOrganization && !organizationID.IsNull(),
+		scope != actionsRunnerScopeRepository && !repositoryID.IsNull():
+		diags.AddError(
+			"Unable to get runner registration token",
+			fmt.Sprintf(
+				"Attributes organization_id and repository_id cannot be used with scope '%s'",
+				scope,
+			),
+		)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	switch scope {
+	case actionsRunnerScopeOrganization:
+		// Use Forgejo client to get organization
+		org, diags := getOrganizationByID(ctx, client, organizationID.ValueInt64())
+		if diags.HasError() {
+			return nil, diags
+		}
+		target = fmt.Sprintf("organization '%s'", org.UserName)
+
+		tflog.Info(ctx, "Get organization runner registration token", map[string]any{
+			"organization": org.UserName,
+		})
+
+		// Use Forgejo client to get organization runner registration token
+		token, res, err = client.GetOrgActionRunnerRegistrationToken(org.UserName)
+	case actionsRunnerScopeRepository:
+		// Use Forgejo client to get repository
+		rep, diags := getRepositoryByID(ctx, client, repositoryID.ValueInt64())
+		if diags.HasError() {
+			return nil, diags
+		}
+		target = fmt.Sprintf("repository '%s'", rep.FullName)
+
+		tflog.Info(ctx, "Get repository runner registration token", map[string]any{
+			"owner": rep.Owner.UserName,
+			"repo":  rep.Name,
+		})
+
+		// Use Forgejo client to get repository runner registration token
+		token, res, err = client.GetRepoActionRunnerRegistrationToken(
+			rep.Owner.UserName,
+			rep.Name,
+		)
+	case actionsRunnerScopeUser:
+		target = "authenticated user"
+
+		tflog.Info(ctx, "Get user runner registration token")
+
+		// Use Forgejo client to get user runner registration token
+		token, res, err = client.GetUserActionRunnerRegistrationToken()
+	default:
+		diags.AddError(
+			"Unable to get runner registration token",
+			fmt.Sprintf(
+				"Unknown runner registration token scope '%s'",
+				scope,
+			),
+		)
+
+		return nil, diags
+	}
+	if err == nil {
+		return token, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 403:
+			msg = fmt.Sprintf(
+				"Runner registration token for %s forbidden: %s",
+				target,
+				err,
+			)
+		case 404:
+			msg = fmt.Sprintf(
+				"Runner registration token for %s not found: %s",
+				target,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to get runner registration token", msg)
+
+	return nil, diags
+}
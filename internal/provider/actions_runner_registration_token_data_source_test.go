@@ -0,0 +1,127 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccActionsRunnerRegistrationTokenDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (missing scope)
+			{
+				Config: providerConfig + `
+data "forgejo_actions_runner_registration_token" "test" {
+}`,
+				ExpectError: regexp.MustCompile(`The argument "scope" is required`),
+			},
+			// Read testing (invalid scope)
+			{
+				Config: providerConfig + `
+data "forgejo_actions_runner_registration_token" "test" {
+	scope = "global"
+}`,
+				ExpectError: regexp.MustCompile("Attribute scope value must be one of"),
+			},
+			// Read testing (unsupported instance scope)
+			{
+				Config: providerConfig + `
+data "forgejo_actions_runner_registration_token" "test" {
+	scope = "instance"
+}`,
+				ExpectError: regexp.MustCompile("Attribute scope value must be one of"),
+			},
+			// Read testing (conflicting identifiers)
+			{
+				Config: providerConfig + `
+data "forgejo_actions_runner_registration_token" "test" {
+	scope           = "organization"
+	organization_id = 1
+	repository_id   = 1
+}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read testing (missing identifier)
+			{
+				Config: providerConfig + `
+data "forgejo_actions_runner_registration_token" "test" {
+	scope = "repository"
+}`,
+				ExpectError: regexp.MustCompile("Attribute repository_id is required for scope 'repository'"),
+			},
+			// Read testing (identifier not matching scope)
+			{
+				Config: providerConfig + `
+data "forgejo_actions_runner_registration_token" "test" {
+	scope         = "user"
+	repository_id = 1
+}`,
+				ExpectError: regexp.MustCompile("Attributes organization_id and repository_id cannot be used with scope 'user'"),
+			},
+			// Read testing (non-existent organization)
+			{
+				Config: providerConfig + `
+data "forgejo_actions_runner_registration_token" "test" {
+	scope           = "organization"
+	organization_id = 1011
+}`,
+				ExpectError: regexp.MustCompile("Organization with ID 1011 not found"),
+			},
+			// Read testing (non-existent repository)
+			{
+				Config: providerConfig + `
+data "forgejo_actions_runner_registration_token" "test" {
+	scope         = "repository"
+	repository_id = -1
+}`,
+				ExpectError: regexp.MustCompile("Repository with ID -1 not found"),
+			},
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+data "forgejo_actions_runner_registration_token" "user" {
+	scope = "user"
+}
+data "forgejo_actions_runner_registration_token" "organization" {
+	scope           = "organization"
+	organization_id = forgejo_organization.test.id
+}
+data "forgejo_actions_runner_registration_token" "repository" {
+	scope         = "repository"
+	repository_id = forgejo_repository.test.id
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("data.forgejo_actions_runner_registration_token.organization", plancheck.ResourceActionRead),
+						plancheck.ExpectResourceAction("data.forgejo_actions_runner_registration_token.repository", plancheck.ResourceActionRead),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.forgejo_actions_runner_registration_token.user", tfjsonpath.New("scope"), knownvalue.StringExact("user")),
+					statecheck.ExpectKnownValue("data.forgejo_actions_runner_registration_token.user", tfjsonpath.New("organization_id"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("data.forgejo_actions_runner_registration_token.user", tfjsonpath.New("repository_id"), knownvalue.Null()),
+					statecheck.ExpectSensitiveValue("data.forgejo_actions_runner_registration_token.user", tfjsonpath.New("token")),
+					statecheck.ExpectKnownValue("data.forgejo_actions_runner_registration_token.user", tfjsonpath.New("token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("data.forgejo_actions_runner_registration_token.organization", tfjsonpath.New("organization_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("data.forgejo_actions_runner_registration_token.organization", tfjsonpath.New("token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("data.forgejo_actions_runner_registration_token.repository", tfjsonpath.New("repository_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("data.forgejo_actions_runner_registration_token.repository", tfjsonpath.New("token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &actionsRunnerRegistrationTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &actionsRunnerRegistrationTokenEphemeralResource{}
+)
+
+// actionsRunnerRegistrationTokenEphemeralResource is the ephemeral resource implementation.
+type actionsRunnerRegistrationTokenEphemeralResource struct {
+	client *forgejo.Client
+}
+
+// actionsRunnerRegistrationTokenEphemeralResourceModel maps the ephemeral resource schema data.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#RunnerRegistrationToken
+type actionsRunnerRegistrationTokenEphemeralResourceModel struct {
+	Scope          types.String `tfsdk:"scope"`
+	OrganizationID types.Int64  `tfsdk:"organization_id"`
+	RepositoryID   types.Int64  `tfsdk:"repository_id"`
+	Token          types.String `tfsdk:"token"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *actionsRunnerRegistrationTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_actions_runner_registration_token"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *actionsRunnerRegistrationTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo Actions runner registration token ephemeral resource.
+
+Returns a token to register Forgejo Actions runners for an organization, a repository or the authenticated user, depending on ` + "`scope`" + `. The token is never stored in the Terraform plan or state.
+
+**Note**: Ephemeral resources require Terraform 1.10 or later!`,
+
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Scope of the registration token. Use `organization` with `organization_id`, `repository` with `repository_id`, or `user` for the authenticated user. **Note**: Instance-wide registration tokens are not available, as the Forgejo SDK does not expose them.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						actionsRunnerScopeOrganization,
+						actionsRunnerScopeRepository,
+						actionsRunnerScopeUser,
+					),
+				},
+			},
+			"organization_id": schema.Int64Attribute{
+				MarkdownDescription: "Numeric identifier of the organization. Required for scope `organization`. **Note**: Conflicts with `repository_id`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.Expressions{
+						path.MatchRoot("repository_id"),
+					}...),
+				},
+			},
+			"repository_id": schema.Int64Attribute{
+				MarkdownDescription: "Numeric identifier of the repository. Required for scope `repository`. **Note**: Conflicts with `organization_id`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.Expressions{
+						path.MatchRoot("organization_id"),
+					}...),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "Runner registration token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *actionsRunnerRegistrationTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	e.client = client
+}
+
+// Open fetches the runner registration token.
+func (e *actionsRunnerRegistrationTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	defer un(trace(ctx, "Open actions runner registration token ephemeral resource"))
+
+	var data actionsRunnerRegistrationTokenEphemeralResourceModel
+
+	// Read Terraform configuration data into model
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get runner registration token
+	token, diags := getActionsRunnerRegistrationToken(
+		ctx,
+		e.client,
+		data.Scope.ValueString(),
+		data.OrganizationID,
+		data.RepositoryID,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	data.Token = types.StringValue(token.Token)
+
+	// Save data into ephemeral result data
+	diags = resp.Result.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewActionsRunnerRegistrationTokenEphemeralResource is a helper function to simplify the provider implementation.
+func NewActionsRunnerRegistrationTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &actionsRunnerRegistrationTokenEphemeralResource{}
+}
@@ -0,0 +1,74 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccActionsRunnerRegistrationTokenEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"forgejo": testAccProtoV6ProviderFactories["forgejo"],
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			// Open testing (unsupported instance scope)
+			{
+				Config: providerConfig + `
+ephemeral "forgejo_actions_runner_registration_token" "test" {
+	scope = "instance"
+}
+provider "echo" {
+	data = ephemeral.forgejo_actions_runner_registration_token.test
+}
+resource "echo" "test" {}`,
+				ExpectError: regexp.MustCompile("Attribute scope value must be one of"),
+			},
+			// Open testing (non-existent repository)
+			{
+				Config: providerConfig + `
+ephemeral "forgejo_actions_runner_registration_token" "test" {
+	scope         = "repository"
+	repository_id = -1
+}
+provider "echo" {
+	data = ephemeral.forgejo_actions_runner_registration_token.test
+}
+resource "echo" "test" {}`,
+				ExpectError: regexp.MustCompile("Repository with ID -1 not found"),
+			},
+			// Open testing
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+ephemeral "forgejo_actions_runner_registration_token" "test" {
+	scope         = "repository"
+	repository_id = forgejo_repository.test.id
+}
+provider "echo" {
+	data = ephemeral.forgejo_actions_runner_registration_token.test
+}
+resource "echo" "test" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("scope"), knownvalue.StringExact("repository")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("repository_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
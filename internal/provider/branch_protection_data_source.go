@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &branchProtectionDataSource{}
+	_ datasource.DataSourceWithConfigure = &branchProtectionDataSource{}
+)
+
+// branchProtectionDataSource is the data source implementation.
+type branchProtectionDataSource struct {
+	client *forgejo.Client
+}
+
+// branchProtectionDataSourceModel maps the data source schema data.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#BranchProtection
+type branchProtectionDataSourceModel struct {
+	RepositoryID                  types.Int64  `tfsdk:"repository_id"`
+	BranchName                    types.String `tfsdk:"branch_name"`
+	EnablePush                    types.Bool   `tfsdk:"enable_push"`
+	EnablePushWhitelist           types.Bool   `tfsdk:"enable_push_whitelist"`
+	PushWhitelistUsernames        types.Set    `tfsdk:"push_whitelist_usernames"`
+	PushWhitelistTeams            types.Set    `tfsdk:"push_whitelist_teams"`
+	PushWhitelistDeployKeys       types.Bool   `tfsdk:"push_whitelist_deploy_keys"`
+	EnableStatusCheck             types.Bool   `tfsdk:"enable_status_check"`
+	StatusCheckContexts           types.List   `tfsdk:"status_check_contexts"`
+	RequireSignedCommits          types.Bool   `tfsdk:"require_signed_commits"`
+	ProtectedFilePatterns         types.String `tfsdk:"protected_file_patterns"`
+	UnprotectedFilePatterns       types.String `tfsdk:"unprotected_file_patterns"`
+	EnableMergeWhitelist          types.Bool   `tfsdk:"enable_merge_whitelist"`
+	MergeWhitelistUsernames       types.Set    `tfsdk:"merge_whitelist_usernames"`
+	MergeWhitelistTeams           types.Set    `tfsdk:"merge_whitelist_teams"`
+	EnableApprovalsWhitelist      types.Bool   `tfsdk:"enable_approvals_whitelist"`
+	ApprovalsWhitelistUsernames   types.Set    `tfsdk:"approvals_whitelist_usernames"`
+	ApprovalsWhitelistTeams       types.Set    `tfsdk:"approvals_whitelist_teams"`
+	RequiredApprovals             types.Int64  `tfsdk:"required_approvals"`
+	BlockOnRejectedReviews        types.Bool   `tfsdk:"block_on_rejected_reviews"`
+	BlockOnOfficialReviewRequests types.Bool   `tfsdk:"block_on_official_review_requests"`
+	BlockOnOutdatedBranch         types.Bool   `tfsdk:"block_on_outdated_branch"`
+	DismissStaleApprovals         types.Bool   `tfsdk:"dismiss_stale_approvals"`
+}
+
+func (m branchProtectionDataSourceModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"repository_id":                     types.Int64Type,
+		"branch_name":                       types.StringType,
+		"enable_push":                       types.BoolType,
+		"enable_push_whitelist":             types.BoolType,
+		"push_whitelist_usernames":          types.SetType{ElemType: types.StringType},
+		"push_whitelist_teams":              types.SetType{ElemType: types.StringType},
+		"push_whitelist_deploy_keys":        types.BoolType,
+		"enable_status_check":               types.BoolType,
+		"status_check_contexts":             types.ListType{ElemType: types.StringType},
+		"require_signed_commits":            types.BoolType,
+		"protected_file_patterns":           types.StringType,
+		"unprotected_file_patterns":         types.StringType,
+		"enable_merge_whitelist":            types.BoolType,
+		"merge_whitelist_usernames":         types.SetType{ElemType: types.StringType},
+		"merge_whitelist_teams":             types.SetType{ElemType: types.StringType},
+		"enable_approvals_whitelist":        types.BoolType,
+		"approvals_whitelist_usernames":     types.SetType{ElemType: types.StringType},
+		"approvals_whitelist_teams":         types.SetType{ElemType: types.StringType},
+		"required_approvals":                types.Int64Type,
+		"block_on_rejected_reviews":         types.BoolType,
+		"block_on_official_review_requests": types.BoolType,
+		"block_on_outdated_branch":          types.BoolType,
+		"dismiss_stale_approvals":           types.BoolType,
+	}
+}
+
+// from is a helper function to load an API struct into Terraform data model.
+func (m *branchProtectionDataSourceModel) from(ctx context.Context, bp *forgejo.BranchProtection) (diags diag.Diagnostics) {
+	if bp == nil {
+		return diags
+	}
+
+	m.BranchName = types.StringValue(bp.BranchName)
+	m.EnablePush = types.BoolValue(bp.EnablePush)
+	m.EnablePushWhitelist = types.BoolValue(bp.EnablePushWhitelist)
+	m.PushWhitelistDeployKeys = types.BoolValue(bp.PushWhitelistDeployKeys)
+	m.EnableStatusCheck = types.BoolValue(bp.EnableStatusCheck)
+	m.RequireSignedCommits = types.BoolValue(bp.RequireSignedCommits)
+	m.ProtectedFilePatterns = types.StringValue(bp.ProtectedFilePatterns)
+	m.UnprotectedFilePatterns = types.StringValue(bp.UnprotectedFilePatterns)
+	m.EnableMergeWhitelist = types.BoolValue(bp.EnableMergeWhitelist)
+	m.EnableApprovalsWhitelist = types.BoolValue(bp.EnableApprovalsWhitelist)
+	m.RequiredApprovals = types.Int64Value(bp.RequiredApprovals)
+	m.BlockOnRejectedReviews = types.BoolValue(bp.BlockOnRejectedReviews)
+	m.BlockOnOfficialReviewRequests = types.BoolValue(bp.BlockOnOfficialReviewRequests)
+	m.BlockOnOutdatedBranch = types.BoolValue(bp.BlockOnOutdatedBranch)
+	m.DismissStaleApprovals = types.BoolValue(bp.DismissStaleApprovals)
+
+	// Handle Lists (nil slices are mapped to empty collections)
+	var d diag.Diagnostics
+	sets := []struct {
+		target *types.Set
+		values []string
+	}{
+		{&m.PushWhitelistUsernames, bp.PushWhitelistUsernames},
+		{&m.PushWhitelistTeams, bp.PushWhitelistTeams},
+		{&m.MergeWhitelistUsernames, bp.MergeWhitelistUsernames},
+		{&m.MergeWhitelistTeams, bp.MergeWhitelistTeams},
+		{&m.ApprovalsWhitelistUsernames, bp.ApprovalsWhitelistUsernames},
+		{&m.ApprovalsWhitelistTeams, bp.ApprovalsWhitelistTeams},
+	}
+	for _, s := range sets {
+		*s.target, d = types.SetValueFrom(ctx, types.StringType, append([]string{}, s.values...))
+		diags.Append(d...)
+	}
+
+	m.StatusCheckContexts, d = types.ListValueFrom(ctx, types.StringType, append([]string{}, bp.StatusCheckContexts...))
+	diags.Append(d...)
+
+	return diags
+}
+
+// Metadata returns the data source type name.
+func (d *branchProtectionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_protection"
+}
+
+// Schema defines the schema for the data source.
+func (d *branchProtectionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := branchProtectionDataSourceAttributes()
+	attributes["repository_id"] = schema.Int64Attribute{
+		Description: "Numeric identifier of the repository.",
+		Required:    true,
+	}
+	attributes["branch_name"] = schema.StringAttribute{
+		Description: "Name of the protected branch (or branch pattern).",
+		Required:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Forgejo branch protection data source.",
+
+		Attributes: attributes,
+	}
+}
+
+// branchProtectionDataSourceAttributes returns the computed branch protection
+// attributes shared by the branch protection and branch protections data
+// sources.
+func branchProtectionDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"repository_id": schema.Int64Attribute{
+			Description: "Numeric identifier of the repository.",
+			Computed:    true,
+		},
+		"branch_name": schema.StringAttribute{
+			Description: "Name of the protected branch (or branch pattern).",
+			Computed:    true,
+		},
+		"enable_push": schema.BoolAttribute{
+			Description: "Enable push to the branch.",
+			Computed:    true,
+		},
+		"enable_push_whitelist": schema.BoolAttribute{
+			Description: "Restrict push to whitelisted users or teams.",
+			Computed:    true,
+		},
+		"push_whitelist_usernames": schema.SetAttribute{
+			Description: "Whitelisted users for pushing.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"push_whitelist_teams": schema.SetAttribute{
+			Description: "Whitelisted teams for pushing.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"push_whitelist_deploy_keys": schema.BoolAttribute{
+			Description: "Whitelist deploy keys with write access to push.",
+			Computed:    true,
+		},
+		"enable_status_check": schema.BoolAttribute{
+			Description: "Enable status check.",
+			Computed:    true,
+		},
+		"status_check_contexts": schema.ListAttribute{
+			Description: "Status check patterns.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"require_signed_commits": schema.BoolAttribute{
+			Description: "Require signed commits.",
+			Computed:    true,
+		},
+		"protected_file_patterns": schema.StringAttribute{
+			Description: "Protected file patterns (separated using semicolon ';').",
+			Computed:    true,
+		},
+		"unprotected_file_patterns": schema.StringAttribute{
+			Description: "Unprotected file patterns (separated using semicolon ';').",
+			Computed:    true,
+		},
+		"enable_merge_whitelist": schema.BoolAttribute{
+			Description: "Restrict merge to whitelisted users or teams.",
+			Computed:    true,
+		},
+		"merge_whitelist_usernames": schema.SetAttribute{
+			Description: "Whitelisted users for merging.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"merge_whitelist_teams": schema.SetAttribute{
+			Description: "Whitelisted teams for merging.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"enable_approvals_whitelist": schema.BoolAttribute{
+			Description: "Restrict approvals to whitelisted users or teams.",
+			Computed:    true,
+		},
+		"approvals_whitelist_usernames": schema.SetAttribute{
+			Description: "Whitelisted users for reviewing.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"approvals_whitelist_teams": schema.SetAttribute{
+			Description: "Whitelisted teams for reviewing.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"required_approvals": schema.Int64Attribute{
+			Description: "Number of required approvals.",
+			Computed:    true,
+		},
+		"block_on_rejected_reviews": schema.BoolAttribute{
+			Description: "Block merge on rejected reviews.",
+			Computed:    true,
+		},
+		"block_on_official_review_requests": schema.BoolAttribute{
+			Description: "Block merge on official review requests.",
+			Computed:    true,
+		},
+		"block_on_outdated_branch": schema.BoolAttribute{
+			Description: "Block merge if pull request is outdated.",
+			Computed:    true,
+		},
+		"dismiss_stale_approvals": schema.BoolAttribute{
+			Description: "Dismiss stale approvals.",
+			Computed:    true,
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *branchProtectionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *branchProtectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer un(trace(ctx, "Read branch protection data source"))
+
+	var data branchProtectionDataSourceModel
+
+	// Read Terraform configuration data into model
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository by id
+	rep, diags := getRepositoryByID(
+		ctx,
+		d.client,
+		data.RepositoryID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get branch protection
+	protection, diags := getBranchProtection(
+		ctx,
+		d.client,
+		rep.Owner.UserName,
+		rep.Name,
+		data.BranchName.ValueString(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	diags = data.from(ctx, protection)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewBranchProtectionDataSource is a helper function to simplify the provider implementation.
+func NewBranchProtectionDataSource() datasource.DataSource {
+	return &branchProtectionDataSource{}
+}
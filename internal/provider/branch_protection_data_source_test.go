@@ -0,0 +1,85 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccBranchProtectionDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (non-existent repository)
+			{
+				Config: providerConfig + `
+data "forgejo_branch_protection" "test" {
+	repository_id = -1
+	branch_name   = "main"
+}`,
+				ExpectError: regexp.MustCompile("Repository with ID -1 not found"),
+			},
+			// Read testing (non-existent branch protection)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+data "forgejo_branch_protection" "test" {
+	repository_id = forgejo_repository.test.id
+	branch_name   = "non_existent"
+}`,
+				ExpectError: regexp.MustCompile(`Branch protection with owner '` + forgejoTestUser + `', repo 'test_repo'\s+and\s+name\s+'non_existent'\s+not\s+found`),
+			},
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+resource "forgejo_branch_protection" "test" {
+	repository_id = forgejo_repository.test.id
+	branch_name   = "main"
+
+	enable_status_check     = true
+	status_check_contexts   = ["ci/build", "ci/test"]
+	protected_file_patterns = "*.lock"
+	required_approvals      = 2
+	dismiss_stale_approvals = true
+}
+data "forgejo_branch_protection" "test" {
+	repository_id = forgejo_branch_protection.test.repository_id
+	branch_name   = forgejo_branch_protection.test.branch_name
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("data.forgejo_branch_protection.test", plancheck.ResourceActionRead),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs("data.forgejo_branch_protection.test", tfjsonpath.New("repository_id"), "forgejo_repository.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue("data.forgejo_branch_protection.test", tfjsonpath.New("branch_name"), knownvalue.StringExact("main")),
+					statecheck.ExpectKnownValue("data.forgejo_branch_protection.test", tfjsonpath.New("enable_push"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("data.forgejo_branch_protection.test", tfjsonpath.New("push_whitelist_usernames"), knownvalue.SetSizeExact(0)),
+					statecheck.ExpectKnownValue("data.forgejo_branch_protection.test", tfjsonpath.New("enable_status_check"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("data.forgejo_branch_protection.test", tfjsonpath.New("status_check_contexts"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("ci/build"),
+						knownvalue.StringExact("ci/test"),
+					})),
+					statecheck.ExpectKnownValue("data.forgejo_branch_protection.test", tfjsonpath.New("protected_file_patterns"), knownvalue.StringExact("*.lock")),
+					statecheck.ExpectKnownValue("data.forgejo_branch_protection.test", tfjsonpath.New("unprotected_file_patterns"), knownvalue.StringExact("")),
+					statecheck.ExpectKnownValue("data.forgejo_branch_protection.test", tfjsonpath.New("required_approvals"), knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue("data.forgejo_branch_protection.test", tfjsonpath.New("dismiss_stale_approvals"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("data.forgejo_branch_protection.test", tfjsonpath.New("block_on_rejected_reviews"), knownvalue.Bool(false)),
+				},
+			},
+		},
+	})
+}
@@ -511,8 +511,9 @@ func (r *branchProtectionResource) Read(ctx context.Context, req resource.ReadRe
 	repo.from(rep)
 
 	// Use Forgejo client to get branch protection
-	protection, diags := r.getBranchProtection(
+	protection, diags := getBranchProtection(
 		ctx,
+		r.client,
 		repo.Owner.ValueString(),
 		repo.Name.ValueString(),
 		data.BranchName.ValueString(),
@@ -748,8 +749,9 @@ func (r *branchProtectionResource) ImportState(ctx context.Context, req resource
 	owner, repo, branchName := parts[0], parts[1], parts[2]
 
 	// Use Forgejo client to get branch protection
-	protection, diags := r.getBranchProtection(
+	protection, diags := getBranchProtection(
 		ctx,
+		r.client,
 		owner,
 		repo,
 		branchName,
@@ -791,7 +793,7 @@ func NewBranchProtectionResource() resource.Resource {
 }
 
 // getBranchProtection returns the branch protection with the given name from the repository.
-func (r *branchProtectionResource) getBranchProtection(ctx context.Context, owner, repo, name string) (*forgejo.BranchProtection, diag.Diagnostics) {
+func getBranchProtection(ctx context.Context, client *forgejo.Client, owner, repo, name string) (*forgejo.BranchProtection, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	tflog.Info(ctx, "Read branch protection", map[string]any{
@@ -801,7 +803,7 @@ func (r *branchProtectionResource) getBranchProtection(ctx context.Context, owne
 	})
 
 	// Use Forgejo client to get branch protection
-	protection, res, err := r.client.GetBranchProtection(
+	protection, res, err := client.GetBranchProtection(
 		owner,
 		repo,
 		name,
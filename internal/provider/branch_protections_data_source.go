@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &branchProtectionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &branchProtectionsDataSource{}
+)
+
+// branchProtectionsDataSource is the data source implementation.
+type branchProtectionsDataSource struct {
+	client *forgejo.Client
+}
+
+// branchProtectionsDataSourceModel maps the data source schema data.
+type branchProtectionsDataSourceModel struct {
+	RepositoryID      types.Int64 `tfsdk:"repository_id"`
+	BranchProtections types.List  `tfsdk:"branch_protections"`
+}
+
+// Metadata returns the data source type name.
+func (d *branchProtectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_protections"
+}
+
+// Schema defines the schema for the data source.
+func (d *branchProtectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forgejo branch protections data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"repository_id": schema.Int64Attribute{
+				Description: "Numeric identifier of the repository.",
+				Required:    true,
+			},
+			"branch_protections": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: branchProtectionDataSourceAttributes(),
+				},
+				Description: "Branch protections of the repository, sorted by branch name.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *branchProtectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *branchProtectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer un(trace(ctx, "Read branch protections data source"))
+
+	var data branchProtectionsDataSourceModel
+
+	// Read Terraform configuration data into model
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository by id
+	rep, diags := getRepositoryByID(
+		ctx,
+		d.client,
+		data.RepositoryID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to list branch protections
+	bps, diags := listBranchProtections(
+		ctx,
+		d.client,
+		rep.Owner.UserName,
+		rep.Name,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sort branch protections by branch name for a stable order
+	slices.SortFunc(bps, func(a, b *forgejo.BranchProtection) int {
+		return strings.Compare(a.BranchName, b.BranchName)
+	})
+
+	// Map response body to model
+	protections := make([]branchProtectionDataSourceModel, len(bps))
+	for i, bp := range bps {
+		protections[i].RepositoryID = data.RepositoryID
+		diags = protections[i].from(ctx, bp)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	data.BranchProtections, diags = types.ListValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: branchProtectionDataSourceModel{}.attributeTypes()},
+		protections,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewBranchProtectionsDataSource is a helper function to simplify the provider implementation.
+func NewBranchProtectionsDataSource() datasource.DataSource {
+	return &branchProtectionsDataSource{}
+}
+
+// listBranchProtections is a helper function to list all branch protections
+// of a repository.
+func listBranchProtections(ctx context.Context, client *forgejo.Client, owner, repo string) ([]*forgejo.BranchProtection, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "List branch protections", map[string]any{
+		"owner": owner,
+		"repo":  repo,
+	})
+
+	// Use Forgejo client to list branch protections. The endpoint is not
+	// paginated and always returns all rules of the repository.
+	bps, res, err := client.ListBranchProtections(
+		owner,
+		repo,
+		forgejo.ListBranchProtectionsOptions{
+			ListOptions: forgejo.ListOptions{Page: -1},
+		},
+	)
+	if err == nil {
+		return bps, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 403:
+			msg = fmt.Sprintf(
+				"Branch protections with owner '%s' and repo '%s' forbidden: %s",
+				owner,
+				repo,
+				err,
+			)
+		case 404:
+			msg = fmt.Sprintf(
+				"Repository with owner '%s' and name '%s' not found: %s",
+				owner,
+				repo,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to list branch protections", msg)
+
+	return nil, diags
+}
@@ -0,0 +1,83 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccBranchProtectionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (non-existent repository)
+			{
+				Config: providerConfig + `
+data "forgejo_branch_protections" "test" {
+	repository_id = -1
+}`,
+				ExpectError: regexp.MustCompile("Repository with ID -1 not found"),
+			},
+			// Read testing (no branch protections)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+data "forgejo_branch_protections" "test" {
+	repository_id = forgejo_repository.test.id
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.forgejo_branch_protections.test", tfjsonpath.New("branch_protections"), knownvalue.ListSizeExact(0)),
+				},
+			},
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+resource "forgejo_branch_protection" "main" {
+	repository_id      = forgejo_repository.test.id
+	branch_name        = "main"
+	required_approvals = 2
+}
+resource "forgejo_branch_protection" "release" {
+	repository_id          = forgejo_repository.test.id
+	branch_name            = "release/*"
+	require_signed_commits = true
+}
+data "forgejo_branch_protections" "test" {
+	repository_id = forgejo_repository.test.id
+	depends_on    = [forgejo_branch_protection.main, forgejo_branch_protection.release]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("data.forgejo_branch_protections.test", plancheck.ResourceActionRead),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.forgejo_branch_protections.test", tfjsonpath.New("branch_protections"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"repository_id":          knownvalue.NotNull(),
+							"branch_name":            knownvalue.StringExact("main"),
+							"required_approvals":     knownvalue.Int64Exact(2),
+							"require_signed_commits": knownvalue.Bool(false),
+						}),
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"branch_name":            knownvalue.StringExact("release/*"),
+							"required_approvals":     knownvalue.Int64Exact(0),
+							"require_signed_commits": knownvalue.Bool(true),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
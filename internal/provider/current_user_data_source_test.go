@@ -0,0 +1,35 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccCurrentUserDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "forgejo_current_user" "test" {}
+data "forgejo_user" "test" {
+	login = "` + forgejoTestUser + `"
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.forgejo_current_user.test", tfjsonpath.New("login"), knownvalue.StringExact(forgejoTestUser)),
+					statecheck.ExpectKnownValue("data.forgejo_current_user.test", tfjsonpath.New("email"), knownvalue.StringExact(forgejoTestEmail)),
+					statecheck.ExpectKnownValue("data.forgejo_current_user.test", tfjsonpath.New("admin"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("data.forgejo_current_user.test", tfjsonpath.New("active"), knownvalue.Bool(true)),
+					statecheck.CompareValuePairs("data.forgejo_current_user.test", tfjsonpath.New("id"), "data.forgejo_user.test", tfjsonpath.New("id"), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
@@ -185,7 +185,9 @@ func (r *gpgKeyResource) Metadata(_ context.Context, req resource.MetadataReques
 // Schema defines the schema for the resource.
 func (r *gpgKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Forgejo user GPG key resource.",
+		MarkdownDescription: `Forgejo user GPG key resource.
+
+**Note**: GPG keys are always managed for the authenticated user, as Forgejo does not provide administrative endpoints to manage GPG keys of other users. Use a provider configuration authenticated as the target user instead.`,
 
 		Attributes: map[string]schema.Attribute{
 			"armored_public_key": schema.StringAttribute{
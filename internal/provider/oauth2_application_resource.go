@@ -0,0 +1,491 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &oauth2ApplicationResource{}
+	_ resource.ResourceWithConfigure   = &oauth2ApplicationResource{}
+	_ resource.ResourceWithImportState = &oauth2ApplicationResource{}
+)
+
+// oauth2ApplicationResource is the resource implementation.
+type oauth2ApplicationResource struct {
+	client *forgejo.Client
+}
+
+// oauth2ApplicationResourceModel maps the resource schema data.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#Oauth2
+type oauth2ApplicationResourceModel struct {
+	ID                  types.Int64  `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	RedirectURIs        types.List   `tfsdk:"redirect_uris"`
+	ConfidentialClient  types.Bool   `tfsdk:"confidential_client"`
+	ClientID            types.String `tfsdk:"client_id"`
+	ClientSecret        types.String `tfsdk:"client_secret"`
+	ClientSecretTrigger types.String `tfsdk:"client_secret_trigger"`
+	Created             types.String `tfsdk:"created_at"`
+}
+
+// from is a helper function to load an API struct into Terraform data model.
+func (m *oauth2ApplicationResourceModel) from(ctx context.Context, a *forgejo.Oauth2) (diags diag.Diagnostics) {
+	if a == nil {
+		return diags
+	}
+
+	m.ID = types.Int64Value(a.ID)
+	m.Name = types.StringValue(a.Name)
+	m.ConfidentialClient = types.BoolValue(a.ConfidentialClient)
+	m.ClientID = types.StringValue(a.ClientID)
+	m.Created = types.StringValue(a.Created.Format(time.RFC3339))
+
+	var d diag.Diagnostics
+	m.RedirectURIs, d = types.ListValueFrom(ctx, types.StringType, a.RedirectURIs)
+	diags.Append(d...)
+
+	// ClientSecret intentionally omitted (API only returns it on creation and update)
+
+	return diags
+}
+
+// to is a helper function to save Terraform data model into an API struct.
+func (m *oauth2ApplicationResourceModel) to(ctx context.Context, o *forgejo.CreateOauth2Option) (diags diag.Diagnostics) {
+	if o == nil {
+		return diags
+	}
+
+	o.Name = m.Name.ValueString()
+	o.ConfidentialClient = m.ConfidentialClient.ValueBool()
+
+	d := m.RedirectURIs.ElementsAs(ctx, &o.RedirectURIs, false)
+	diags.Append(d...)
+
+	return diags
+}
+
+// Metadata returns the resource type name.
+func (r *oauth2ApplicationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oauth2_application"
+}
+
+// Schema defines the schema for the resource.
+func (r *oauth2ApplicationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo OAuth2 application resource.
+
+Manages OAuth2 applications of the authenticated user, which allow other services to use Forgejo as an identity provider.
+
+**Note**: Forgejo generates a new client secret whenever an application is updated. The client secret is therefore unknown until any in-place update has been applied, and it can not be recovered after importing an application.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "Numeric identifier of the OAuth2 application.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the OAuth2 application.",
+				Required:    true,
+			},
+			"redirect_uris": schema.ListAttribute{
+				Description: "Redirect URIs of the OAuth2 application.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"confidential_client": schema.BoolAttribute{
+				Description: "Is the OAuth2 application a confidential client, which can keep its client secret private? Set to 'false' for native and single-page applications.",
+				Computed:    true,
+				Optional:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"client_id": schema.StringAttribute{
+				Description: "Client ID of the OAuth2 application.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				Description: "Client secret of the OAuth2 application.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"client_secret_trigger": schema.StringAttribute{
+				Description: "Arbitrary value, which regenerates the client secret whenever it changes.",
+				Optional:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Time at which the OAuth2 application was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *oauth2ApplicationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *oauth2ApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer un(trace(ctx, "Create OAuth2 application resource"))
+
+	var data oauth2ApplicationResourceModel
+
+	// Read Terraform plan data into model
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Create OAuth2 application", map[string]any{
+		"name":                data.Name.ValueString(),
+		"redirect_uris":       data.RedirectURIs,
+		"confidential_client": data.ConfidentialClient.ValueBool(),
+	})
+
+	// Generate API request body from plan
+	opts := forgejo.CreateOauth2Option{}
+	diags = data.to(ctx, &opts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to create new OAuth2 application
+	app, res, err := r.client.CreateOauth2(opts)
+	if err != nil {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			tflog.Error(ctx, "Error", map[string]any{
+				"status": res.Status,
+			})
+
+			switch res.StatusCode {
+			case 400:
+				msg = fmt.Sprintf(
+					"Bad request: %s",
+					err,
+				)
+			default:
+				msg = fmt.Sprintf(
+					"Unknown error (status %d): %s",
+					res.StatusCode,
+					err,
+				)
+			}
+		}
+		resp.Diagnostics.AddError("Unable to create OAuth2 application", msg)
+
+		return
+	}
+
+	// Map response body to model
+	diags = data.from(ctx, app)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The client secret is only returned on creation and update
+	data.ClientSecret = types.StringValue(app.ClientSecret)
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *oauth2ApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer un(trace(ctx, "Read OAuth2 application resource"))
+
+	var data oauth2ApplicationResourceModel
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get OAuth2 application
+	app, diags := getOAuth2Application(ctx, r.client, data.ID.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	diags = data.from(ctx, app)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *oauth2ApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer un(trace(ctx, "Update OAuth2 application resource"))
+
+	var data oauth2ApplicationResourceModel
+
+	// Read Terraform plan data into model
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Update OAuth2 application", map[string]any{
+		"id":                  data.ID.ValueInt64(),
+		"name":                data.Name.ValueString(),
+		"redirect_uris":       data.RedirectURIs,
+		"confidential_client": data.ConfidentialClient.ValueBool(),
+	})
+
+	// Generate API request body from plan
+	opts := forgejo.CreateOauth2Option{}
+	diags = data.to(ctx, &opts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to update existing OAuth2 application
+	app, res, err := r.client.UpdateOauth2(data.ID.ValueInt64(), opts)
+	if err != nil {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			tflog.Error(ctx, "Error", map[string]any{
+				"status": res.Status,
+			})
+
+			switch res.StatusCode {
+			case 404:
+				msg = fmt.Sprintf(
+					"OAuth2 application with ID %d not found: %s",
+					data.ID.ValueInt64(),
+					err,
+				)
+			default:
+				msg = fmt.Sprintf(
+					"Unknown error (status %d): %s",
+					res.StatusCode,
+					err,
+				)
+			}
+		}
+		resp.Diagnostics.AddError("Unable to update OAuth2 application", msg)
+
+		return
+	}
+
+	// Map response body to model
+	diags = data.from(ctx, app)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Forgejo generates a new client secret on every update
+	data.ClientSecret = types.StringValue(app.ClientSecret)
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *oauth2ApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer un(trace(ctx, "Delete OAuth2 application resource"))
+
+	var data oauth2ApplicationResourceModel
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Delete OAuth2 application", map[string]any{
+		"id": data.ID.ValueInt64(),
+	})
+
+	// Use Forgejo client to delete existing OAuth2 application
+	res, err := r.client.DeleteOauth2(data.ID.ValueInt64())
+	if err == nil {
+		return
+	}
+
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 404:
+			msg = fmt.Sprintf(
+				"OAuth2 application with ID %d not found: %s",
+				data.ID.ValueInt64(),
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	resp.Diagnostics.AddError("Unable to delete OAuth2 application", msg)
+}
+
+// ImportState reads an existing resource and adds it to Terraform state on success.
+func (r *oauth2ApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer un(trace(ctx, "Import OAuth2 application resource"))
+
+	var state oauth2ApplicationResourceModel
+
+	// Parse import identifier
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to parse import identifier",
+			fmt.Sprintf(
+				"Expected numeric OAuth2 application ID, got: '%s'",
+				req.ID,
+			),
+		)
+
+		return
+	}
+
+	// Use Forgejo client to get OAuth2 application
+	app, diags := getOAuth2Application(ctx, r.client, id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	diags = state.from(ctx, app)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The client secret can not be recovered
+	state.ClientSecret = types.StringNull()
+	state.ClientSecretTrigger = types.StringNull()
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewOAuth2ApplicationResource is a helper function to simplify the provider implementation.
+func NewOAuth2ApplicationResource() resource.Resource {
+	return &oauth2ApplicationResource{}
+}
+
+// getOAuth2Application fetches an OAuth2 application of the authenticated user by ID and handles errors consistently.
+func getOAuth2Application(ctx context.Context, client *forgejo.Client, id int64) (*forgejo.Oauth2, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Get OAuth2 application", map[string]any{
+		"id": id,
+	})
+
+	// Use Forgejo client to get OAuth2 application
+	app, res, err := client.GetOauth2(id)
+	if err == nil {
+		return app, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 404:
+			msg = fmt.Sprintf(
+				"OAuth2 application with ID %d not found: %s",
+				id,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to read OAuth2 application", msg)
+
+	return nil, diags
+}
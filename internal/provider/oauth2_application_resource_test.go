@@ -0,0 +1,134 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccOAuth2ApplicationResource(t *testing.T) {
+	clientID := statecheck.CompareValue(compare.ValuesSame())
+	clientSecret := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing (no redirect URIs)
+			{
+				Config: providerConfig + `
+resource "forgejo_oauth2_application" "test" {
+	name          = "tftest"
+	redirect_uris = []
+}`,
+				ExpectError: regexp.MustCompile("Attribute redirect_uris list must contain at least 1 elements"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_oauth2_application" "test" {
+	name          = "tftest"
+	redirect_uris = ["https://localhost/callback"]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_oauth2_application.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_oauth2_application.test", tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("forgejo_oauth2_application.test", tfjsonpath.New("name"), knownvalue.StringExact("tftest")),
+					statecheck.ExpectKnownValue("forgejo_oauth2_application.test", tfjsonpath.New("redirect_uris"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("https://localhost/callback"),
+					})),
+					statecheck.ExpectKnownValue("forgejo_oauth2_application.test", tfjsonpath.New("confidential_client"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("forgejo_oauth2_application.test", tfjsonpath.New("client_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("forgejo_oauth2_application.test", tfjsonpath.New("client_secret"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("forgejo_oauth2_application.test", tfjsonpath.New("client_secret_trigger"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("forgejo_oauth2_application.test", tfjsonpath.New("created_at"), knownvalue.NotNull()),
+					clientID.AddStateValue("forgejo_oauth2_application.test", tfjsonpath.New("client_id")),
+					clientSecret.AddStateValue("forgejo_oauth2_application.test", tfjsonpath.New("client_secret")),
+				},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_oauth2_application" "test" {
+	name                = "tftest_updated"
+	confidential_client = false
+	redirect_uris = [
+		"https://localhost/callback",
+		"http://127.0.0.1:8080/callback",
+	]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_oauth2_application.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_oauth2_application.test", tfjsonpath.New("name"), knownvalue.StringExact("tftest_updated")),
+					statecheck.ExpectKnownValue("forgejo_oauth2_application.test", tfjsonpath.New("redirect_uris"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("https://localhost/callback"),
+						knownvalue.StringExact("http://127.0.0.1:8080/callback"),
+					})),
+					statecheck.ExpectKnownValue("forgejo_oauth2_application.test", tfjsonpath.New("confidential_client"), knownvalue.Bool(false)),
+					clientID.AddStateValue("forgejo_oauth2_application.test", tfjsonpath.New("client_id")),
+					clientSecret.AddStateValue("forgejo_oauth2_application.test", tfjsonpath.New("client_secret")),
+				},
+			},
+			// Regenerate client secret
+			{
+				Config: providerConfig + `
+resource "forgejo_oauth2_application" "test" {
+	name                  = "tftest_updated"
+	confidential_client   = false
+	client_secret_trigger = "rotate"
+	redirect_uris = [
+		"https://localhost/callback",
+		"http://127.0.0.1:8080/callback",
+	]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_oauth2_application.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("forgejo_oauth2_application.test", tfjsonpath.New("client_secret")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_oauth2_application.test", tfjsonpath.New("client_secret_trigger"), knownvalue.StringExact("rotate")),
+					clientID.AddStateValue("forgejo_oauth2_application.test", tfjsonpath.New("client_id")),
+					clientSecret.AddStateValue("forgejo_oauth2_application.test", tfjsonpath.New("client_secret")),
+				},
+			},
+			// Import testing (invalid identifier)
+			{
+				ResourceName:  "forgejo_oauth2_application.test",
+				ImportState:   true,
+				ImportStateId: "invalid",
+				ExpectError:   regexp.MustCompile("Expected numeric OAuth2 application ID, got: 'invalid'"),
+			},
+			// Import testing (non-existent resource)
+			{
+				ResourceName:  "forgejo_oauth2_application.test",
+				ImportState:   true,
+				ImportStateId: "0",
+				ExpectError:   regexp.MustCompile("OAuth2 application with ID 0 not found"),
+			},
+			// Import testing
+			{
+				ResourceName:            "forgejo_oauth2_application.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret", "client_secret_trigger"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -76,7 +76,7 @@ func (r *organizationActionSecretResource) Schema(_ context.Context, _ resource.
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Forgejo organization action secret resource.
 
-**Note**: The authenticated user must be a member of the managed organization(s) or have administrative privileges!`,
+**Note**: The authenticated user must be a member of the managed organization(s) or have administrative privileges! Forgejo does not return secret data. Secrets recreated outside of Terraform are detected by their ` + "`created_at`" + ` timestamp and updated with the configured data.`,
 
 		Attributes: map[string]schema.Attribute{
 			"organization": schema.StringAttribute{
@@ -284,6 +284,22 @@ func (r *organizationActionSecretResource) Read(ctx context.Context, req resourc
 		return
 	}
 
+	/*
+	 * Forgejo never returns the secret data, so changes made outside of
+	 * Terraform can only be detected by a differing creation time. In that
+	 * case, the data is cleared from the state to force an update.
+	 */
+	if !data.CreatedAt.IsNull() &&
+		data.CreatedAt.ValueString() != secret.Created.Format(time.RFC3339) {
+		tflog.Warn(ctx, "Organization action secret changed outside of Terraform", map[string]any{
+			"organization": data.Organization.ValueString(),
+			"name":         data.Name.ValueString(),
+			"created_at":   secret.Created.Format(time.RFC3339),
+		})
+
+		data.Data = types.StringNull()
+	}
+
 	// Map response body to model
 	data.from(secret)
 
@@ -442,48 +458,9 @@ func NewOrganizationActionSecretResource() resource.Resource {
 
 // getSecret returns the secret with the given name from the organization.
 func (r *organizationActionSecretResource) getSecret(ctx context.Context, org, name string) (*forgejo.Secret, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	tflog.Info(ctx, "List organization action secrets", map[string]any{
-		"org":  org,
-		"name": name,
-	})
-
 	// Use Forgejo client to list organization action secrets
-	secrets, res, err := r.client.ListOrgActionSecret(
-		org,
-		forgejo.ListOrgActionSecretOption{
-			ListOptions: forgejo.ListOptions{
-				Page: -1,
-			},
-		},
-	)
-	if err != nil {
-		var msg string
-		if res == nil {
-			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
-		} else {
-			tflog.Error(ctx, "Error", map[string]any{
-				"status": res.Status,
-			})
-
-			switch res.StatusCode {
-			case 404:
-				msg = fmt.Sprintf(
-					"Action secrets with organization '%s' not found: %s",
-					org,
-					err,
-				)
-			default:
-				msg = fmt.Sprintf(
-					"Unknown error (status %d): %s",
-					res.StatusCode,
-					err,
-				)
-			}
-		}
-		diags.AddError("Unable to list organization action secrets", msg)
-
+	secrets, diags := listOrganizationActionSecrets(ctx, r.client, org)
+	if diags.HasError() {
 		return nil, diags
 	}
 
@@ -3,6 +3,7 @@ package provider_test
 import (
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -10,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
 )
 
 func TestAccOrganizationActionSecretResource(t *testing.T) {
@@ -142,6 +145,52 @@ resource "forgejo_organization_action_secret" "test_by_name" {
 					statecheck.ExpectKnownValue("forgejo_organization_action_secret.test_by_name", tfjsonpath.New("created_at"), knownvalue.NotNull()),
 				},
 			},
+			// Update and Read testing (secret recreated outside of Terraform)
+			{
+				PreConfig: func() {
+					client := testAccClient(t)
+
+					// Creation times have a resolution of one second
+					time.Sleep(time.Second)
+
+					_, err := client.DeleteOrgActionSecret("test_org", "my_new_secret_by_id")
+					if err != nil {
+						t.Fatalf("Unable to delete organization action secret: %s", err)
+					}
+					_, err = client.CreateOrgActionSecret("test_org", forgejo.CreateSecretOption{
+						Name: "my_new_secret_by_id",
+						Data: "out_of_band_value",
+					})
+					if err != nil {
+						t.Fatalf("Unable to create organization action secret: %s", err)
+					}
+				},
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_organization_action_secret" "test_by_id" {
+	organization_id = forgejo_organization.test.id
+	name            = "my_new_secret_by_id"
+	data            = "my_new_secret_value"
+}
+resource "forgejo_organization_action_secret" "test_by_name" {
+	organization = forgejo_organization.test.name
+	name         = "my_new_secret_by_name"
+	data         = "my_new_secret_value"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_organization_action_secret.test_by_id", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("forgejo_organization_action_secret.test_by_name", plancheck.ResourceActionNoop),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_organization_action_secret.test_by_id", tfjsonpath.New("name"), knownvalue.StringExact("my_new_secret_by_id")),
+					statecheck.ExpectSensitiveValue("forgejo_organization_action_secret.test_by_id", tfjsonpath.New("data")),
+					statecheck.ExpectKnownValue("forgejo_organization_action_secret.test_by_id", tfjsonpath.New("created_at"), knownvalue.NotNull()),
+				},
+			},
 			// Recreate and Read testing (long name)
 			{
 				Config: providerConfig + `
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &organizationActionSecretsDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationActionSecretsDataSource{}
+)
+
+// organizationActionSecretsDataSource is the data source implementation.
+type organizationActionSecretsDataSource struct {
+	client *forgejo.Client
+}
+
+// organizationActionSecretsDataSourceModel maps the data source schema data.
+type organizationActionSecretsDataSourceModel struct {
+	Organization   types.String `tfsdk:"organization"`
+	OrganizationID types.Int64  `tfsdk:"organization_id"`
+	Secrets        types.List   `tfsdk:"secrets"`
+}
+
+// Metadata returns the data source type name.
+func (d *organizationActionSecretsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_action_secrets"
+}
+
+// Schema defines the schema for the data source.
+func (d *organizationActionSecretsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo organization action secrets data source.
+
+Lists the names and creation times of all action secrets of an organization. Secret values are never returned by Forgejo.`,
+
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Name of the owning organization. **Note**: One of `organization` or `organization_id` must be specified.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("organization_id"),
+					}...),
+				},
+			},
+			"organization_id": schema.Int64Attribute{
+				MarkdownDescription: "Numeric identifier of the owning organization. **Note**: One of `organization` or `organization_id` must be specified.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("organization"),
+					}...),
+				},
+			},
+			"secrets": actionSecretsDataSourceAttribute("Action secrets of the organization, sorted by name."),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *organizationActionSecretsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *organizationActionSecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer un(trace(ctx, "Read organization action secrets data source"))
+
+	var data organizationActionSecretsDataSourceModel
+
+	// Read Terraform configuration data into model
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get organization by ID or name
+	var org *forgejo.Organization
+	if data.Organization.IsNull() || data.Organization.IsUnknown() {
+		org, diags = getOrganizationByID(
+			ctx,
+			d.client,
+			data.OrganizationID.ValueInt64(),
+		)
+	} else {
+		org, diags = getOrganizationByName(
+			ctx,
+			d.client,
+			data.Organization.ValueString(),
+		)
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Organization = types.StringValue(org.UserName)
+	data.OrganizationID = types.Int64Value(org.ID)
+
+	// Use Forgejo client to list organization action secrets
+	secrets, diags := listOrganizationActionSecrets(ctx, d.client, org.UserName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	data.Secrets, diags = actionSecretsValueFrom(ctx, secrets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewOrganizationActionSecretsDataSource is a helper function to simplify the provider implementation.
+func NewOrganizationActionSecretsDataSource() datasource.DataSource {
+	return &organizationActionSecretsDataSource{}
+}
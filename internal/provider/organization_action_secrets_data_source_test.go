@@ -0,0 +1,81 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccOrganizationActionSecretsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (non-existent org by ID)
+			{
+				Config: providerConfig + `
+data "forgejo_organization_action_secrets" "test" {
+	organization_id = 1011
+}`,
+				ExpectError: regexp.MustCompile("Organization with ID 1011 not found"),
+			},
+			// Read testing (non-existent org by name)
+			{
+				Config: providerConfig + `
+data "forgejo_organization_action_secrets" "test" {
+	organization = "non-existent"
+}`,
+				ExpectError: regexp.MustCompile("Organization with name 'non-existent' not found"),
+			},
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_organization_action_secrets" "test" {
+	organization_id = forgejo_organization.test.id
+	secrets = {
+		my_secret       = { data = "my_secret_value" }
+		my_other_secret = { data = "my_other_secret_value" }
+	}
+}
+data "forgejo_organization_action_secrets" "test_by_id" {
+	organization_id = forgejo_organization.test.id
+	depends_on      = [forgejo_organization_action_secrets.test]
+}
+data "forgejo_organization_action_secrets" "test_by_name" {
+	organization = forgejo_organization.test.name
+	depends_on   = [forgejo_organization_action_secrets.test]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("data.forgejo_organization_action_secrets.test_by_id", plancheck.ResourceActionRead),
+						plancheck.ExpectResourceAction("data.forgejo_organization_action_secrets.test_by_name", plancheck.ResourceActionRead),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.forgejo_organization_action_secrets.test_by_id", tfjsonpath.New("organization"), knownvalue.StringExact("test_org")),
+					statecheck.CompareValuePairs("data.forgejo_organization_action_secrets.test_by_name", tfjsonpath.New("organization_id"), "forgejo_organization.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue("data.forgejo_organization_action_secrets.test_by_id", tfjsonpath.New("secrets"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"name":       knownvalue.StringExact("MY_OTHER_SECRET"),
+							"created_at": knownvalue.NotNull(),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"name":       knownvalue.StringExact("MY_SECRET"),
+							"created_at": knownvalue.NotNull(),
+						}),
+					})),
+					statecheck.CompareValuePairs("data.forgejo_organization_action_secrets.test_by_id", tfjsonpath.New("secrets"), "data.forgejo_organization_action_secrets.test_by_name", tfjsonpath.New("secrets"), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
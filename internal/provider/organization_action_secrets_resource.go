@@ -0,0 +1,544 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &organizationActionSecretsResource{}
+	_ resource.ResourceWithConfigure = &organizationActionSecretsResource{}
+)
+
+// organizationActionSecretsResource is the resource implementation.
+type organizationActionSecretsResource struct {
+	client *forgejo.Client
+}
+
+// organizationActionSecretsResourceModel maps the resource schema data.
+type organizationActionSecretsResourceModel struct {
+	Organization    types.String `tfsdk:"organization"`
+	OrganizationID  types.Int64  `tfsdk:"organization_id"`
+	Secrets         types.Map    `tfsdk:"secrets"`
+	DeleteUnmanaged types.Bool   `tfsdk:"delete_unmanaged"`
+}
+
+// from is a helper function to load API data into Terraform data model.
+func (m *organizationActionSecretsResourceModel) from(ctx context.Context, secrets []*forgejo.Secret) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Secrets, diags = actionSecretsValue(
+		ctx,
+		m.Secrets,
+		secrets,
+		m.DeleteUnmanaged.ValueBool(),
+	)
+
+	return diags
+}
+
+// Metadata returns the resource type name.
+func (r *organizationActionSecretsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_action_secrets"
+}
+
+// Schema defines the schema for the resource.
+func (r *organizationActionSecretsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo organization action secrets resource.
+
+This resource manages multiple action secrets of an organization at once. Secrets not listed in ` + "`secrets`" + ` are left untouched, unless ` + "`delete_unmanaged`" + ` is enabled.
+
+**Note**: The authenticated user must be a member of the managed organization(s) or have administrative privileges! Do not combine this resource with ` + "`forgejo_organization_action_secret`" + ` resources for the same organization, as they will conflict with each other!`,
+
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Name of the owning organization. Changing this forces a new resource to be created. **Note**: One of `organization` or `organization_id` must be specified.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("organization_id"),
+					}...),
+				},
+			},
+			"organization_id": schema.Int64Attribute{
+				MarkdownDescription: "Numeric identifier of the owning organization. Changing this forces a new resource to be created. **Note**: One of `organization` or `organization_id` must be specified.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("organization"),
+					}...),
+				},
+			},
+			"secrets": schema.MapNestedAttribute{
+				Description: "Map of secret names to secrets.",
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthBetween(1, 255)),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"data": schema.StringAttribute{
+							// Write-only attribute
+							Description: "Data of the secret.",
+							Required:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"delete_unmanaged": schema.BoolAttribute{
+				// Provider behavior flag
+				MarkdownDescription: "Delete secrets of the organization which are not listed in `secrets`?",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *organizationActionSecretsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *organizationActionSecretsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer un(trace(ctx, "Create organization action secrets resource"))
+
+	var data organizationActionSecretsResourceModel
+
+	// Read Terraform plan data into the model
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get organization name from ID if not provided
+	if data.Organization.IsNull() || data.Organization.IsUnknown() {
+		org, diags := getOrganizationByID(
+			ctx,
+			r.client,
+			data.OrganizationID.ValueInt64(),
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Map response body to model
+		data.Organization = types.StringValue(org.UserName)
+	} else {
+		// Clear organization ID if name is provided
+		data.OrganizationID = types.Int64Value(0)
+	}
+
+	// Use Forgejo client to set organization action secrets
+	diags = r.setSecrets(
+		ctx,
+		data.Organization.ValueString(),
+		nil,
+		&data,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *organizationActionSecretsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer un(trace(ctx, "Read organization action secrets resource"))
+
+	var data organizationActionSecretsResourceModel
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to list organization action secrets
+	secrets, diags := listOrganizationActionSecrets(
+		ctx,
+		r.client,
+		data.Organization.ValueString(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	diags = data.from(ctx, secrets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *organizationActionSecretsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer un(trace(ctx, "Update organization action secrets resource"))
+
+	var (
+		state organizationActionSecretsResourceModel
+		plan  organizationActionSecretsResourceModel
+		prior map[string]actionSecretsResourceSecret
+	)
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform plan data into the model
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = state.Secrets.ElementsAs(ctx, &prior, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to set organization action secrets
+	diags = r.setSecrets(
+		ctx,
+		state.Organization.ValueString(),
+		prior,
+		&plan,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Copy organization info to plan data
+	plan.Organization = state.Organization
+	plan.OrganizationID = state.OrganizationID
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *organizationActionSecretsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer un(trace(ctx, "Delete organization action secrets resource"))
+
+	var (
+		data    organizationActionSecretsResourceModel
+		secrets map[string]actionSecretsResourceSecret
+	)
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = data.Secrets.ElementsAs(ctx, &secrets, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to delete organization action secrets
+	for _, name := range slices.Sorted(maps.Keys(secrets)) {
+		diags = r.deleteSecret(
+			ctx,
+			data.Organization.ValueString(),
+			name,
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// NewOrganizationActionSecretsResource is a helper function to simplify the provider implementation.
+func NewOrganizationActionSecretsResource() resource.Resource {
+	return &organizationActionSecretsResource{}
+}
+
+// setSecrets reconciles the action secrets of an organization with the
+// Terraform data model by creating missing, updating changed and deleting
+// removed secrets. Secrets not contained in the prior state are only
+// deleted if the delete_unmanaged flag is set.
+func (r *organizationActionSecretsResource) setSecrets(ctx context.Context, org string, prior map[string]actionSecretsResourceSecret, data *organizationActionSecretsResourceModel) diag.Diagnostics {
+	var desired map[string]actionSecretsResourceSecret
+
+	diags := data.Secrets.ElementsAs(ctx, &desired, false)
+	if diags.HasError() {
+		return diags
+	}
+	names := slices.Sorted(maps.Keys(desired))
+	priorNames := slices.Collect(maps.Keys(prior))
+
+	// Use Forgejo client to list current organization action secrets
+	current, diags := listOrganizationActionSecrets(ctx, r.client, org)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Create missing and update changed secrets
+	for _, name := range names {
+		equalName := func(n string) bool {
+			return strings.EqualFold(n, name)
+		}
+		exists := slices.ContainsFunc(current, func(s *forgejo.Secret) bool {
+			return equalName(s.Name)
+		})
+		idx := slices.IndexFunc(priorNames, equalName)
+		if exists && idx != -1 && prior[priorNames[idx]].Data.Equal(desired[name].Data) {
+			continue
+		}
+
+		diags.Append(r.createSecret(ctx, org, name, desired[name].Data.ValueString())...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// Delete removed and unmanaged secrets
+	for _, secret := range current {
+		equalName := func(n string) bool {
+			return strings.EqualFold(n, secret.Name)
+		}
+		if slices.ContainsFunc(names, equalName) {
+			continue
+		}
+		if !data.DeleteUnmanaged.ValueBool() && !slices.ContainsFunc(priorNames, equalName) {
+			continue
+		}
+
+		diags.Append(r.deleteSecret(ctx, org, secret.Name)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// createSecret creates or updates an action secret in the organization.
+func (r *organizationActionSecretsResource) createSecret(ctx context.Context, org, name, data string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Create organization action secret", map[string]any{
+		"organization": org,
+		"name":         name,
+		"data":         strings.Repeat("*", len(data)),
+	})
+
+	// Generate API request body
+	opts := forgejo.CreateSecretOption{
+		Name: name,
+		Data: data,
+	}
+
+	// Validate API request body
+	err := opts.Validate()
+	if err != nil {
+		diags.AddError("Input validation error", err.Error())
+
+		return diags
+	}
+
+	// Use Forgejo client to create or update organization action secret
+	res, err := r.client.CreateOrgActionSecret(org, opts)
+	if err == nil {
+		return diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 400:
+			msg = fmt.Sprintf("Bad request: %s", err)
+		case 404:
+			msg = fmt.Sprintf(
+				"Organization with name '%s' not found: %s",
+				org,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to create organization action secret", msg)
+
+	return diags
+}
+
+// deleteSecret deletes an action secret of the organization.
+func (r *organizationActionSecretsResource) deleteSecret(ctx context.Context, org, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Delete organization action secret", map[string]any{
+		"organization": org,
+		"name":         name,
+	})
+
+	// Use Forgejo client to delete existing organization action secret
+	res, err := r.client.DeleteOrgActionSecret(org, name)
+	if err == nil {
+		return diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 400:
+			msg = fmt.Sprintf("Bad request: %s", err)
+		case 404:
+			msg = fmt.Sprintf(
+				"Action secret with organization '%s' and name '%s' not found: %s",
+				org,
+				name,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to delete organization action secret", msg)
+
+	return diags
+}
+
+// listOrganizationActionSecrets is a helper function to fetch all action
+// secrets of an organization.
+func listOrganizationActionSecrets(ctx context.Context, client *forgejo.Client, org string) ([]*forgejo.Secret, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "List organization action secrets", map[string]any{
+		"organization": org,
+	})
+
+	// Use Forgejo client to list organization action secrets
+	secrets, res, err := listAllPages(func(opts forgejo.ListOptions) ([]*forgejo.Secret, *forgejo.Response, error) {
+		return client.ListOrgActionSecret(org, forgejo.ListOrgActionSecretOption{
+			ListOptions: opts,
+		})
+	})
+	if err == nil {
+		return secrets, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 404:
+			msg = fmt.Sprintf(
+				"Organization with name '%s' not found: %s",
+				org,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to list organization action secrets", msg)
+
+	return nil, diags
+}
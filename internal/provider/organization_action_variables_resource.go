@@ -0,0 +1,601 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &organizationActionVariablesResource{}
+	_ resource.ResourceWithConfigure = &organizationActionVariablesResource{}
+)
+
+// organizationActionVariablesResource is the resource implementation.
+type organizationActionVariablesResource struct {
+	client *forgejo.Client
+}
+
+// organizationActionVariablesResourceModel maps the resource schema data.
+type organizationActionVariablesResourceModel struct {
+	Organization    types.String `tfsdk:"organization"`
+	OrganizationID  types.Int64  `tfsdk:"organization_id"`
+	Variables       types.Map    `tfsdk:"variables"`
+	DeleteUnmanaged types.Bool   `tfsdk:"delete_unmanaged"`
+}
+
+// from is a helper function to load API data into Terraform data model.
+func (m *organizationActionVariablesResourceModel) from(ctx context.Context, variables []*forgejo.ActionVariable) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Variables, diags = actionVariablesValue(
+		ctx,
+		m.Variables,
+		variables,
+		m.DeleteUnmanaged.ValueBool(),
+	)
+
+	return diags
+}
+
+// Metadata returns the resource type name.
+func (r *organizationActionVariablesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_action_variables"
+}
+
+// Schema defines the schema for the resource.
+func (r *organizationActionVariablesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo organization action variables resource.
+
+This resource manages multiple action variables of an organization at once. Variables not listed in ` + "`variables`" + ` are left untouched, unless ` + "`delete_unmanaged`" + ` is enabled.
+
+**Note**: The authenticated user must be a member of the managed organization(s) or have administrative privileges! Do not combine this resource with ` + "`forgejo_organization_action_variable`" + ` resources for the same organization, as they will conflict with each other!`,
+
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Name of the owning organization. Changing this forces a new resource to be created. **Note**: One of `organization` or `organization_id` must be specified.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("organization_id"),
+					}...),
+				},
+			},
+			"organization_id": schema.Int64Attribute{
+				MarkdownDescription: "Numeric identifier of the owning organization. Changing this forces a new resource to be created. **Note**: One of `organization` or `organization_id` must be specified.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("organization"),
+					}...),
+				},
+			},
+			"variables": schema.MapAttribute{
+				Description: "Map of variable names to variable data.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthBetween(1, 255)),
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"delete_unmanaged": schema.BoolAttribute{
+				// Provider behavior flag
+				MarkdownDescription: "Delete variables of the organization which are not listed in `variables`?",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *organizationActionVariablesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *organizationActionVariablesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer un(trace(ctx, "Create organization action variables resource"))
+
+	var data organizationActionVariablesResourceModel
+
+	// Read Terraform plan data into model
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get organization name from ID if not provided
+	if data.Organization.IsNull() || data.Organization.IsUnknown() {
+		org, diags := getOrganizationByID(
+			ctx,
+			r.client,
+			data.OrganizationID.ValueInt64(),
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Map response body to model
+		data.Organization = types.StringValue(org.UserName)
+	} else {
+		// Clear organization ID if name is provided
+		data.OrganizationID = types.Int64Value(0)
+	}
+
+	// Use Forgejo client to set organization action variables
+	diags = r.setVariables(
+		ctx,
+		data.Organization.ValueString(),
+		nil,
+		&data,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *organizationActionVariablesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer un(trace(ctx, "Read organization action variables resource"))
+
+	var data organizationActionVariablesResourceModel
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to list organization action variables
+	variables, diags := listOrganizationActionVariables(
+		ctx,
+		r.client,
+		data.Organization.ValueString(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	diags = data.from(ctx, variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *organizationActionVariablesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer un(trace(ctx, "Update organization action variables resource"))
+
+	var (
+		state organizationActionVariablesResourceModel
+		plan  organizationActionVariablesResourceModel
+		prior map[string]string
+	)
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform plan data into model
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = state.Variables.ElementsAs(ctx, &prior, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to set organization action variables
+	diags = r.setVariables(
+		ctx,
+		state.Organization.ValueString(),
+		slices.Collect(maps.Keys(prior)),
+		&plan,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Copy organization info to plan data
+	plan.Organization = state.Organization
+	plan.OrganizationID = state.OrganizationID
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *organizationActionVariablesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer un(trace(ctx, "Delete organization action variables resource"))
+
+	var (
+		data      organizationActionVariablesResourceModel
+		variables map[string]string
+	)
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = data.Variables.ElementsAs(ctx, &variables, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to delete organization action variables
+	for _, name := range slices.Sorted(maps.Keys(variables)) {
+		diags = r.deleteVariable(
+			ctx,
+			data.Organization.ValueString(),
+			name,
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// NewOrganizationActionVariablesResource is a helper function to simplify the provider implementation.
+func NewOrganizationActionVariablesResource() resource.Resource {
+	return &organizationActionVariablesResource{}
+}
+
+// setVariables reconciles the action variables of an organization with the
+// Terraform data model by creating missing, updating changed and deleting
+// removed variables. Variables not contained in the prior state are only
+// deleted if the delete_unmanaged flag is set.
+func (r *organizationActionVariablesResource) setVariables(ctx context.Context, org string, prior []string, data *organizationActionVariablesResourceModel) diag.Diagnostics {
+	var desired map[string]string
+
+	diags := data.Variables.ElementsAs(ctx, &desired, false)
+	if diags.HasError() {
+		return diags
+	}
+	names := slices.Sorted(maps.Keys(desired))
+
+	// Use Forgejo client to list current organization action variables
+	current, diags := listOrganizationActionVariables(ctx, r.client, org)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Create missing and update changed variables
+	for _, name := range names {
+		idx := slices.IndexFunc(current, func(v *forgejo.ActionVariable) bool {
+			return strings.EqualFold(v.Name, name)
+		})
+
+		switch {
+		case idx == -1:
+			diags.Append(r.createVariable(ctx, org, name, desired[name])...)
+		case current[idx].Data != desired[name]:
+			diags.Append(r.updateVariable(ctx, org, current[idx].Name, name, desired[name])...)
+		}
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// Delete removed and unmanaged variables
+	for _, variable := range current {
+		equalName := func(n string) bool {
+			return strings.EqualFold(n, variable.Name)
+		}
+		if slices.ContainsFunc(names, equalName) {
+			continue
+		}
+		if !data.DeleteUnmanaged.ValueBool() && !slices.ContainsFunc(prior, equalName) {
+			continue
+		}
+
+		diags.Append(r.deleteVariable(ctx, org, variable.Name)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// createVariable creates an action variable in the organization.
+func (r *organizationActionVariablesResource) createVariable(ctx context.Context, org, name, data string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Create organization action variable", map[string]any{
+		"organization": org,
+		"name":         name,
+		"data":         data,
+	})
+
+	// Generate API request body
+	opts := forgejo.CreateVariableOption{
+		Name: name,
+		Data: data,
+	}
+
+	// Validate API request body
+	err := opts.Validate()
+	if err != nil {
+		diags.AddError("Input validation error", err.Error())
+
+		return diags
+	}
+
+	// Use Forgejo client to create new organization action variable
+	res, err := r.client.CreateOrgActionVariable(org, opts)
+	if err == nil {
+		return diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 400:
+			msg = fmt.Sprintf("Bad request: %s", err)
+		case 404:
+			msg = fmt.Sprintf(
+				"Organization with name '%s' not found: %s",
+				org,
+				err,
+			)
+		case 409:
+			msg = fmt.Sprintf(
+				"Action variable with organization '%s' and name '%s' conflict: %s",
+				org,
+				name,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to create organization action variable", msg)
+
+	return diags
+}
+
+// updateVariable updates an action variable of the organization.
+func (r *organizationActionVariablesResource) updateVariable(ctx context.Context, org, oldName, newName, data string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Update organization action variable", map[string]any{
+		"organization": org,
+		"old_name":     oldName,
+		"new_name":     newName,
+		"data":         data,
+	})
+
+	// Generate API request body
+	opts := forgejo.CreateVariableOption{
+		Name: newName,
+		Data: data,
+	}
+
+	// Validate API request body
+	err := opts.Validate()
+	if err != nil {
+		diags.AddError("Input validation error", err.Error())
+
+		return diags
+	}
+
+	// Use Forgejo client to update organization action variable
+	res, err := r.client.UpdateOrgActionVariable(org, oldName, opts)
+	if err == nil {
+		return diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 400:
+			msg = fmt.Sprintf("Bad request: %s", err)
+		case 404:
+			msg = fmt.Sprintf(
+				"Action variable with organization '%s' and name '%s' not found: %s",
+				org,
+				oldName,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to update organization action variable", msg)
+
+	return diags
+}
+
+// deleteVariable deletes an action variable of the organization.
+func (r *organizationActionVariablesResource) deleteVariable(ctx context.Context, org, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Delete organization action variable", map[string]any{
+		"organization": org,
+		"name":         name,
+	})
+
+	// Use Forgejo client to delete existing organization action variable
+	res, err := r.client.DeleteOrgActionVariable(org, name)
+	if err == nil {
+		return diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 400:
+			msg = fmt.Sprintf("Bad request: %s", err)
+		case 404:
+			msg = fmt.Sprintf(
+				"Action variable with organization '%s' and name '%s' not found: %s",
+				org,
+				name,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to delete organization action variable", msg)
+
+	return diags
+}
+
+// listOrganizationActionVariables is a helper function to fetch all action
+// variables of an organization.
+func listOrganizationActionVariables(ctx context.Context, client *forgejo.Client, org string) ([]*forgejo.ActionVariable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "List organization action variables", map[string]any{
+		"organization": org,
+	})
+
+	// Use Forgejo client to list organization action variables
+	variables, res, err := listAllPages(func(opts forgejo.ListOptions) ([]*forgejo.ActionVariable, *forgejo.Response, error) {
+		return client.ListOrgActionVariables(org, opts)
+	})
+	if err == nil {
+		return variables, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 404:
+			msg = fmt.Sprintf(
+				"Organization with name '%s' not found: %s",
+				org,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to list organization action variables", msg)
+
+	return nil, diags
+}
@@ -0,0 +1,128 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccOrganizationActionVariablesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing (non-existent org by ID)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization_action_variables" "test" {
+	organization_id = 1011
+	variables = {
+		my_variable = "my_variable_value"
+	}
+}`,
+				ExpectError: regexp.MustCompile("Organization with ID 1011 not found"),
+			},
+			// Create and Read testing (non-existent org by name)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization_action_variables" "test" {
+	organization = "non-existent"
+	variables = {
+		my_variable = "my_variable_value"
+	}
+}`,
+				ExpectError: regexp.MustCompile("Organization with name 'non-existent' not found"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_organization" "other" {
+	name = "test_other_org"
+}
+resource "forgejo_organization_action_variables" "test_by_id" {
+	organization_id = forgejo_organization.test.id
+	variables = {
+		my_variable       = "my_variable_value"
+		my_other_variable = "my_other_variable_value"
+	}
+}
+resource "forgejo_organization_action_variables" "test_by_name" {
+	organization = forgejo_organization.other.name
+	variables = {
+		my_variable = "my_variable_value"
+	}
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_organization_action_variables.test_by_id", plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction("forgejo_organization_action_variables.test_by_name", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_organization_action_variables.test_by_id", tfjsonpath.New("organization"), knownvalue.StringExact("test_org")),
+					statecheck.CompareValuePairs("forgejo_organization_action_variables.test_by_id", tfjsonpath.New("organization_id"), "forgejo_organization.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue("forgejo_organization_action_variables.test_by_id", tfjsonpath.New("variables"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"my_variable":       knownvalue.StringExact("my_variable_value"),
+						"my_other_variable": knownvalue.StringExact("my_other_variable_value"),
+					})),
+					statecheck.ExpectKnownValue("forgejo_organization_action_variables.test_by_id", tfjsonpath.New("delete_unmanaged"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("forgejo_organization_action_variables.test_by_name", tfjsonpath.New("organization"), knownvalue.StringExact("test_other_org")),
+					statecheck.ExpectKnownValue("forgejo_organization_action_variables.test_by_name", tfjsonpath.New("organization_id"), knownvalue.Int64Exact(0)),
+					statecheck.ExpectKnownValue("forgejo_organization_action_variables.test_by_name", tfjsonpath.New("variables"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"my_variable": knownvalue.StringExact("my_variable_value"),
+					})),
+				},
+			},
+			// Update and Read testing (add, change and remove variables)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_organization" "other" {
+	name = "test_other_org"
+}
+resource "forgejo_organization_action_variables" "test_by_id" {
+	organization_id  = forgejo_organization.test.id
+	delete_unmanaged = true
+	variables = {
+		my_other_variable = "my_new_variable_value"
+		my_new_variable   = "my_variable_value"
+	}
+}
+resource "forgejo_organization_action_variables" "test_by_name" {
+	organization = forgejo_organization.other.name
+	variables = {
+		my_variable = "my_new_variable_value"
+	}
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_organization_action_variables.test_by_id", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("forgejo_organization_action_variables.test_by_name", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_organization_action_variables.test_by_id", tfjsonpath.New("variables"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"my_other_variable": knownvalue.StringExact("my_new_variable_value"),
+						"my_new_variable":   knownvalue.StringExact("my_variable_value"),
+					})),
+					statecheck.ExpectKnownValue("forgejo_organization_action_variables.test_by_id", tfjsonpath.New("delete_unmanaged"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("forgejo_organization_action_variables.test_by_name", tfjsonpath.New("variables"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"my_variable": knownvalue.StringExact("my_new_variable_value"),
+					})),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -38,6 +39,35 @@ type organizationDataSourceModel struct {
 	Visibility  types.String `tfsdk:"visibility"`
 }
 
+func (m organizationDataSourceModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.Int64Type,
+		"name":        types.StringType,
+		"full_name":   types.StringType,
+		"avatar_url":  types.StringType,
+		"description": types.StringType,
+		"website":     types.StringType,
+		"location":    types.StringType,
+		"visibility":  types.StringType,
+	}
+}
+
+// from is a helper function to load an API struct into Terraform data model.
+func (m *organizationDataSourceModel) from(org *forgejo.Organization) {
+	if org == nil {
+		return
+	}
+
+	m.ID = types.Int64Value(org.ID)
+	m.Name = types.StringValue(org.UserName)
+	m.FullName = types.StringValue(org.FullName)
+	m.AvatarURL = types.StringValue(org.AvatarURL)
+	m.Description = types.StringValue(org.Description)
+	m.Website = types.StringValue(org.Website)
+	m.Location = types.StringValue(org.Location)
+	m.Visibility = types.StringValue(org.Visibility)
+}
+
 // Metadata returns the data source type name.
 func (d *organizationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_organization"
@@ -45,42 +75,54 @@ func (d *organizationDataSource) Metadata(_ context.Context, req datasource.Meta
 
 // Schema defines the schema for the data source.
 func (d *organizationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := organizationDataSourceAttributes()
+	attributes["name"] = schema.StringAttribute{
+		Description: "Name of the organization.",
+		Required:    true,
+	}
+
 	resp.Schema = schema.Schema{
 		Description: "Forgejo organization data source.",
 
-		Attributes: map[string]schema.Attribute{
-			"id": schema.Int64Attribute{
-				Description: "Numeric identifier of the organization.",
-				Computed:    true,
-			},
-			"name": schema.StringAttribute{
-				Description: "Name of the organization.",
-				Required:    true,
-			},
-			"full_name": schema.StringAttribute{
-				Description: "Full name of the organization.",
-				Computed:    true,
-			},
-			"avatar_url": schema.StringAttribute{
-				Description: "Avatar URL of the organization.",
-				Computed:    true,
-			},
-			"description": schema.StringAttribute{
-				Description: "Description of the organization.",
-				Computed:    true,
-			},
-			"website": schema.StringAttribute{
-				Description: "Website of the organization.",
-				Computed:    true,
-			},
-			"location": schema.StringAttribute{
-				Description: "Location of the organization.",
-				Computed:    true,
-			},
-			"visibility": schema.StringAttribute{
-				Description: "Visibility of the organization.",
-				Computed:    true,
-			},
+		Attributes: attributes,
+	}
+}
+
+// organizationDataSourceAttributes returns the computed organization attributes
+// shared by the organization and organizations data sources.
+func organizationDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Numeric identifier of the organization.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the organization.",
+			Computed:    true,
+		},
+		"full_name": schema.StringAttribute{
+			Description: "Full name of the organization.",
+			Computed:    true,
+		},
+		"avatar_url": schema.StringAttribute{
+			Description: "Avatar URL of the organization.",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Description of the organization.",
+			Computed:    true,
+		},
+		"website": schema.StringAttribute{
+			Description: "Website of the organization.",
+			Computed:    true,
+		},
+		"location": schema.StringAttribute{
+			Description: "Location of the organization.",
+			Computed:    true,
+		},
+		"visibility": schema.StringAttribute{
+			Description: "Visibility of the organization.",
+			Computed:    true,
 		},
 	}
 }
@@ -133,14 +175,7 @@ func (d *organizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	// Map response body to model
-	data.ID = types.Int64Value(org.ID)
-	data.Name = types.StringValue(org.UserName)
-	data.FullName = types.StringValue(org.FullName)
-	data.AvatarURL = types.StringValue(org.AvatarURL)
-	data.Description = types.StringValue(org.Description)
-	data.Website = types.StringValue(org.Website)
-	data.Location = types.StringValue(org.Location)
-	data.Visibility = types.StringValue(org.Visibility)
+	data.from(org)
 
 	// Save data into Terraform state
 	diags = resp.State.Set(ctx, &data)
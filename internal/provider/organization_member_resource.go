@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &organizationMemberResource{}
+	_ resource.ResourceWithConfigure   = &organizationMemberResource{}
+	_ resource.ResourceWithImportState = &organizationMemberResource{}
+)
+
+// organizationMemberResource is the resource implementation.
+type organizationMemberResource struct {
+	client *forgejo.Client
+}
+
+// organizationMemberResourceModel maps the resource schema data.
+type organizationMemberResourceModel struct {
+	Organization types.String `tfsdk:"organization"`
+	User         types.String `tfsdk:"user"`
+}
+
+// Metadata returns the resource type name.
+func (r *organizationMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_member"
+}
+
+// Schema defines the schema for the resource.
+func (r *organizationMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo organization member resource.
+
+Destroying the resource removes the user from the organization and all of its teams.
+
+**Note**: Forgejo adds users to organizations by means of teams, so the user must already be a member of the organization (e.g. by means of a team). Creating the resource only verifies the membership. Do not combine it with ` + "`forgejo_team_member`" + ` resources for the same user, as those cannot be destroyed once the user has been removed from the organization.`,
+
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description: "Name of the organization. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Description: "Username of the organization member. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *organizationMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *organizationMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer un(trace(ctx, "Create organization member resource"))
+
+	var data organizationMemberResourceModel
+
+	// Read Terraform plan data into model
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to check organization membership
+	_, diags = checkOrganizationMember(
+		ctx,
+		r.client,
+		data.Organization.ValueString(),
+		data.User.ValueString(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *organizationMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer un(trace(ctx, "Read organization member resource"))
+
+	var data organizationMemberResourceModel
+
+	// Read Terraform prior state into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to check organization membership
+	_, diags = checkOrganizationMember(
+		ctx,
+		r.client,
+		data.Organization.ValueString(),
+		data.User.ValueString(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *organizationMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer un(trace(ctx, "Update organization member resource"))
+
+	/*
+	 * Organization members can not be updated in-place. All writable
+	 * attributes have 'RequiresReplace' plan modifier set.
+	 */
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *organizationMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer un(trace(ctx, "Delete organization member resource"))
+
+	var data organizationMemberResourceModel
+
+	// Read Terraform prior state into the model.
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to remove organization member
+	diags = deleteOrganizationMember(
+		ctx,
+		r.client,
+		data.Organization.ValueString(),
+		data.User.ValueString(),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState reads an existing resource and adds it to Terraform state on success.
+func (r *organizationMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer un(trace(ctx, "Import organization member resource"))
+
+	var state organizationMemberResourceModel
+
+	// Parse import identifier
+	cmp := strings.Split(req.ID, "/")
+	if len(cmp) != 2 {
+		resp.Diagnostics.AddError(
+			"Unable to parse import identifier",
+			fmt.Sprintf(
+				"Expected import identifier with format: 'org/user', got: '%s'",
+				req.ID,
+			),
+		)
+
+		return
+	}
+	orgName, userName := cmp[0], cmp[1]
+
+	// Use Forgejo client to check organization membership
+	_, diags := checkOrganizationMember(
+		ctx,
+		r.client,
+		orgName,
+		userName,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	state.Organization = types.StringValue(orgName)
+	state.User = types.StringValue(userName)
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewOrganizationMemberResource is a helper function to simplify the provider implementation.
+func NewOrganizationMemberResource() resource.Resource {
+	return &organizationMemberResource{}
+}
+
+// checkOrganizationMember is a helper function to check the organization
+// membership of a user. It reports whether the membership is public.
+func checkOrganizationMember(ctx context.Context, client *forgejo.Client, org, userName string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Read organization member", map[string]any{
+		"organization": org,
+		"user":         userName,
+	})
+
+	// Use Forgejo client to check organization membership
+	member, res, err := client.CheckOrgMembership(org, userName)
+	if err == nil && !member {
+		diags.AddError(
+			"Unable to read organization member",
+			fmt.Sprintf(
+				"User '%s' in organization '%s' not found",
+				userName,
+				org,
+			),
+		)
+
+		return false, diags
+	}
+
+	// Use Forgejo client to check public organization membership
+	var public bool
+	if err == nil {
+		public, res, err = client.CheckPublicOrgMembership(org, userName)
+	}
+	if err == nil {
+		return public, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 404:
+			msg = fmt.Sprintf(
+				"Either user '%s' or organization '%s' not found: %s",
+				userName,
+				org,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to read organization member", msg)
+
+	return false, diags
+}
+
+// deleteOrganizationMember is a helper function to remove a user from an organization.
+func deleteOrganizationMember(ctx context.Context, client *forgejo.Client, org, userName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Delete organization member", map[string]any{
+		"organization": org,
+		"user":         userName,
+	})
+
+	// Use Forgejo client to remove organization member
+	res, err := client.DeleteOrgMembership(org, userName)
+	if err == nil {
+		return diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 403:
+			msg = fmt.Sprintf(
+				"Removing user '%s' from organization '%s' forbidden: %s",
+				userName,
+				org,
+				err,
+			)
+		case 404:
+			msg = fmt.Sprintf(
+				"Either user '%s' or organization '%s' not found: %s",
+				userName,
+				org,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to delete organization member", msg)
+
+	return diags
+}
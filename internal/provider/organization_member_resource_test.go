@@ -0,0 +1,169 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccOrganizationMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// removed blocks
+			tfversion.SkipBelow(tfversion.Version1_7_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing (non-member)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	name                      = "test_team"
+	organization_id           = forgejo_organization.test.id
+	includes_all_repositories = false
+	units                     = {
+		code = "read"
+	}
+}
+resource "forgejo_user" "test" {
+	login    = "test_user"
+	email    = "test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+resource "forgejo_organization_member" "test" {
+	organization = forgejo_organization.test.name
+	user         = forgejo_user.test.login
+}`,
+				ExpectError: regexp.MustCompile("User 'test_user' in organization 'test_org' not found"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	name                      = "test_team"
+	organization_id           = forgejo_organization.test.id
+	includes_all_repositories = false
+	units                     = {
+		code = "read"
+	}
+}
+resource "forgejo_user" "test" {
+	login    = "test_user"
+	email    = "test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+resource "forgejo_team_member" "test" {
+	team_id = forgejo_team.test.id
+	user    = forgejo_user.test.login
+}
+resource "forgejo_organization_member" "test" {
+	organization = forgejo_organization.test.name
+	user         = forgejo_team_member.test.user
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_organization_member.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_organization_member.test", tfjsonpath.New("organization"), knownvalue.StringExact("test_org")),
+					statecheck.ExpectKnownValue("forgejo_organization_member.test", tfjsonpath.New("user"), knownvalue.StringExact("test_user")),
+				},
+			},
+			// Import testing (invalid identifier)
+			{
+				ResourceName:  "forgejo_organization_member.test",
+				ImportState:   true,
+				ImportStateId: "invalid",
+				ExpectError:   regexp.MustCompile("Expected import identifier with format: 'org/user', got: 'invalid'"),
+			},
+			// Import testing (non-existent user)
+			{
+				ResourceName:  "forgejo_organization_member.test",
+				ImportState:   true,
+				ImportStateId: "test_org/non_existent",
+				ExpectError:   regexp.MustCompile("Unable to read organization member"),
+			},
+			// Import testing
+			{
+				ResourceName:                         "forgejo_organization_member.test",
+				ImportState:                          true,
+				ImportStateId:                        "test_org/test_user",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "user",
+			},
+			// Delete testing (remove member from organization)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	name                      = "test_team"
+	organization_id           = forgejo_organization.test.id
+	includes_all_repositories = false
+	units                     = {
+		code = "read"
+	}
+}
+resource "forgejo_user" "test" {
+	login    = "test_user"
+	email    = "test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+removed {
+	from = forgejo_team_member.test
+	lifecycle {
+		destroy = false
+	}
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_organization_member.test", plancheck.ResourceActionDestroy),
+					},
+				},
+			},
+			// Read testing (member removed from organization)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	name                      = "test_team"
+	organization_id           = forgejo_organization.test.id
+	includes_all_repositories = false
+	units                     = {
+		code = "read"
+	}
+}
+resource "forgejo_user" "test" {
+	login    = "test_user"
+	email    = "test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+data "forgejo_organization_members" "test" {
+	organization = forgejo_organization.test.name
+	depends_on   = [forgejo_user.test]
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.forgejo_organization_members.test", tfjsonpath.New("members"), knownvalue.ListSizeExact(1)),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
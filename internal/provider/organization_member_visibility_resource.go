@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+const (
+	// organizationMemberVisibility* are the supported membership visibilities.
+	organizationMemberVisibilityPublic    = "public"
+	organizationMemberVisibilityConcealed = "concealed"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &organizationMemberVisibilityResource{}
+	_ resource.ResourceWithConfigure   = &organizationMemberVisibilityResource{}
+	_ resource.ResourceWithImportState = &organizationMemberVisibilityResource{}
+)
+
+// organizationMemberVisibilityResource is the resource implementation.
+type organizationMemberVisibilityResource struct {
+	client *forgejo.Client
+}
+
+// organizationMemberVisibilityResourceModel maps the resource schema data.
+type organizationMemberVisibilityResourceModel struct {
+	Organization types.String `tfsdk:"organization"`
+	User         types.String `tfsdk:"user"`
+	Visibility   types.String `tfsdk:"visibility"`
+}
+
+// from is a helper function to load API data into Terraform data model.
+func (m *organizationMemberVisibilityResourceModel) from(public bool) {
+	if public {
+		m.Visibility = types.StringValue(organizationMemberVisibilityPublic)
+	} else {
+		m.Visibility = types.StringValue(organizationMemberVisibilityConcealed)
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *organizationMemberVisibilityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_member_visibility"
+}
+
+// Schema defines the schema for the resource.
+func (r *organizationMemberVisibilityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo organization member visibility resource.
+
+Controls whether the organization membership of the authenticated user is publicly visible. Destroying the resource conceals the membership again. Use the ` + "`forgejo_organization_member`" + ` resource to remove users from an organization.
+
+**Note**: The authenticated user must already be a member of the organization (e.g. by means of a team). Forgejo only allows users to publicize or conceal their own membership, even for administrators!`,
+
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description: "Name of the organization. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Description: "Username of the organization member, i.e. the authenticated user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"visibility": schema.StringAttribute{
+				Description: "Visibility of the organization membership.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						organizationMemberVisibilityPublic,
+						organizationMemberVisibilityConcealed,
+					),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *organizationMemberVisibilityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *organizationMemberVisibilityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer un(trace(ctx, "Create organization member visibility resource"))
+
+	var data organizationMemberVisibilityResourceModel
+
+	// Read Terraform plan data into model
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get authenticated user
+	usr, diags := getCurrentUser(ctx, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.User = types.StringValue(usr.UserName)
+
+	// Use Forgejo client to check organization membership
+	_, diags = checkOrganizationMember(
+		ctx,
+		r.client,
+		data.Organization.ValueString(),
+		data.User.ValueString(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to set organization member visibility
+	diags = setOrganizationMemberVisibility(
+		ctx,
+		r.client,
+		data.Organization.ValueString(),
+		data.User.ValueString(),
+		data.Visibility.ValueString() == organizationMemberVisibilityPublic,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *organizationMemberVisibilityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer un(trace(ctx, "Read organization member visibility resource"))
+
+	var data organizationMemberVisibilityResourceModel
+
+	// Read Terraform prior state into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to check organization membership
+	public, diags := checkOrganizationMember(
+		ctx,
+		r.client,
+		data.Organization.ValueString(),
+		data.User.ValueString(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	data.from(public)
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *organizationMemberVisibilityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer un(trace(ctx, "Update organization member visibility resource"))
+
+	var data organizationMemberVisibilityResourceModel
+
+	// Read Terraform plan data into model
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to set organization member visibility
+	diags = setOrganizationMemberVisibility(
+		ctx,
+		r.client,
+		data.Organization.ValueString(),
+		data.User.ValueString(),
+		data.Visibility.ValueString() == organizationMemberVisibilityPublic,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *organizationMemberVisibilityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer un(trace(ctx, "Delete organization member visibility resource"))
+
+	var data organizationMemberVisibilityResourceModel
+
+	// Read Terraform prior state into the model.
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to conceal organization membership
+	diags = setOrganizationMemberVisibility(
+		ctx,
+		r.client,
+		data.Organization.ValueString(),
+		data.User.ValueString(),
+		false,
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState reads an existing resource and adds it to Terraform state on success.
+func (r *organizationMemberVisibilityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer un(trace(ctx, "Import organization member visibility resource"))
+
+	var state organizationMemberVisibilityResourceModel
+
+	// Use Forgejo client to get authenticated user
+	usr, diags := getCurrentUser(ctx, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to check organization membership
+	public, diags := checkOrganizationMember(
+		ctx,
+		r.client,
+		req.ID,
+		usr.UserName,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	state.Organization = types.StringValue(req.ID)
+	state.User = types.StringValue(usr.UserName)
+	state.from(public)
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewOrganizationMemberVisibilityResource is a helper function to simplify the provider implementation.
+func NewOrganizationMemberVisibilityResource() resource.Resource {
+	return &organizationMemberVisibilityResource{}
+}
+
+// setOrganizationMemberVisibility is a helper function to publicize or conceal
+// the organization membership of a user.
+func setOrganizationMemberVisibility(ctx context.Context, client *forgejo.Client, org, userName string, public bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Update organization member visibility", map[string]any{
+		"organization": org,
+		"user":         userName,
+		"public":       public,
+	})
+
+	// Use Forgejo client to set organization member visibility
+	res, err := client.SetPublicOrgMembership(org, userName, public)
+	if err == nil {
+		return diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 403:
+			msg = fmt.Sprintf(
+				"Changing visibility of user '%s' in organization '%s' forbidden: %s",
+				userName,
+				org,
+				err,
+			)
+		case 404:
+			msg = fmt.Sprintf(
+				"Either user '%s' or organization '%s' not found: %s",
+				userName,
+				org,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to update organization member visibility", msg)
+
+	return diags
+}
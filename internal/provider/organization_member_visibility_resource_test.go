@@ -0,0 +1,96 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccOrganizationMemberVisibilityResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing (invalid visibility)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization_member_visibility" "test" {
+	organization = "test_org"
+	visibility   = "hidden"
+}`,
+				ExpectError: regexp.MustCompile("Attribute visibility value must be one of"),
+			},
+			// Create and Read testing (non-existent organization)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization_member_visibility" "test" {
+	organization = "non_existent_org"
+	visibility   = "public"
+}`,
+				ExpectError: regexp.MustCompile("organization 'non_existent_org' not found"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_organization_member_visibility" "test" {
+	organization = forgejo_organization.test.name
+	visibility   = "public"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_organization_member_visibility.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_organization_member_visibility.test", tfjsonpath.New("organization"), knownvalue.StringExact("test_org")),
+					statecheck.ExpectKnownValue("forgejo_organization_member_visibility.test", tfjsonpath.New("user"), knownvalue.StringExact(forgejoTestUser)),
+					statecheck.ExpectKnownValue("forgejo_organization_member_visibility.test", tfjsonpath.New("visibility"), knownvalue.StringExact("public")),
+				},
+			},
+			// Import testing (non-existent organization)
+			{
+				ResourceName:  "forgejo_organization_member_visibility.test",
+				ImportState:   true,
+				ImportStateId: "non_existent_org",
+				ExpectError:   regexp.MustCompile("organization 'non_existent_org' not found"),
+			},
+			// Import testing
+			{
+				ResourceName:                         "forgejo_organization_member_visibility.test",
+				ImportState:                          true,
+				ImportStateId:                        "test_org",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "organization",
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_organization_member_visibility" "test" {
+	organization = forgejo_organization.test.name
+	visibility   = "concealed"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_organization_member_visibility.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_organization_member_visibility.test", tfjsonpath.New("user"), knownvalue.StringExact(forgejoTestUser)),
+					statecheck.ExpectKnownValue("forgejo_organization_member_visibility.test", tfjsonpath.New("visibility"), knownvalue.StringExact("concealed")),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
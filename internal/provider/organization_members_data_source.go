@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &organizationMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationMembersDataSource{}
+)
+
+// organizationMembersDataSource is the data source implementation.
+type organizationMembersDataSource struct {
+	client *forgejo.Client
+}
+
+// organizationMembersDataSourceModel maps the data source schema data.
+type organizationMembersDataSourceModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Members      types.List   `tfsdk:"members"`
+}
+
+// organizationMembersDataSourceMember maps a single organization member.
+type organizationMembersDataSourceMember struct {
+	ID     types.Int64  `tfsdk:"id"`
+	Login  types.String `tfsdk:"login"`
+	Public types.Bool   `tfsdk:"public"`
+	Teams  types.List   `tfsdk:"teams"`
+}
+
+func (m organizationMembersDataSourceMember) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":     types.Int64Type,
+		"login":  types.StringType,
+		"public": types.BoolType,
+		"teams":  types.ListType{ElemType: types.StringType},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *organizationMembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_members"
+}
+
+// Schema defines the schema for the data source.
+func (d *organizationMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forgejo organization members data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Description: "Name of the organization.",
+				Required:    true,
+			},
+			"members": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Numeric identifier of the user.",
+							Computed:    true,
+						},
+						"login": schema.StringAttribute{
+							Description: "Name of the user.",
+							Computed:    true,
+						},
+						"public": schema.BoolAttribute{
+							Description: "Is the organization membership publicly visible?",
+							Computed:    true,
+						},
+						"teams": schema.ListAttribute{
+							Description: "Names of the organization teams the user is a member of.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+				Description: "Members of the organization, sorted by login.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *organizationMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *organizationMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer un(trace(ctx, "Read organization members data source"))
+
+	var data organizationMembersDataSourceModel
+
+	// Read Terraform configuration data into model
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to list organization members
+	users, diags := listOrganizationMembers(ctx, d.client, data.Organization.ValueString(), false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to list public organization members
+	publicUsers, diags := listOrganizationMembers(ctx, d.client, data.Organization.ValueString(), true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to list organization teams and their members
+	teams, diags := listOrganizationTeamMembers(ctx, d.client, data.Organization.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sort members by login for a stable order
+	slices.SortFunc(users, func(a, b *forgejo.User) int {
+		return strings.Compare(a.UserName, b.UserName)
+	})
+
+	// Map response body to model
+	members := make([]organizationMembersDataSourceMember, 0, len(users))
+	for _, u := range users {
+		teamNames := []string{}
+		for _, t := range teams {
+			if slices.Contains(t.members, u.UserName) {
+				teamNames = append(teamNames, t.name)
+			}
+		}
+		teamsValue, diags := types.ListValueFrom(ctx, types.StringType, teamNames)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		members = append(members, organizationMembersDataSourceMember{
+			ID:    types.Int64Value(u.ID),
+			Login: types.StringValue(u.UserName),
+			Public: types.BoolValue(slices.ContainsFunc(publicUsers, func(p *forgejo.User) bool {
+				return p.ID == u.ID
+			})),
+			Teams: teamsValue,
+		})
+	}
+	data.Members, diags = types.ListValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: organizationMembersDataSourceMember{}.attributeTypes()},
+		members,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewOrganizationMembersDataSource is a helper function to simplify the provider implementation.
+func NewOrganizationMembersDataSource() datasource.DataSource {
+	return &organizationMembersDataSource{}
+}
+
+// listOrganizationMembers is a helper function to list all (or only the
+// public) members of an organization.
+func listOrganizationMembers(ctx context.Context, client *forgejo.Client, org string, public bool) ([]*forgejo.User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "List organization members", map[string]any{
+		"organization": org,
+		"public":       public,
+	})
+
+	// Use Forgejo client to list organization members
+	users, res, err := listAllPages(func(opts forgejo.ListOptions) ([]*forgejo.User, *forgejo.Response, error) {
+		if public {
+			return client.ListPublicOrgMembership(org, forgejo.ListOrgMembershipOption{ListOptions: opts})
+		}
+
+		return client.ListOrgMembership(org, forgejo.ListOrgMembershipOption{ListOptions: opts})
+	})
+	if err == nil {
+		return users, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 404:
+			msg = fmt.Sprintf(
+				"Organization with name '%s' not found: %s",
+				org,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to list organization members", msg)
+
+	return nil, diags
+}
+
+// organizationTeamMembers holds the member names of an organization team.
+type organizationTeamMembers struct {
+	name    string
+	members []string
+}
+
+// listOrganizationTeamMembers is a helper function to list all teams of an
+// organization together with their members.
+func listOrganizationTeamMembers(ctx context.Context, client *forgejo.Client, org string) ([]organizationTeamMembers, diag.Diagnostics) {
+	teams, diags := listOrgTeams(ctx, client, org)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := make([]organizationTeamMembers, 0, len(teams))
+	for _, t := range teams {
+		// Use Forgejo client to list team members
+		members, diags := listTeamMembers(ctx, client, t.ID)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = append(result, organizationTeamMembers{
+			name:    t.Name,
+			members: members,
+		})
+	}
+
+	return result, diags
+}
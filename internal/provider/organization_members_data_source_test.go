@@ -0,0 +1,65 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccOrganizationMembersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (non-existent organization)
+			{
+				Config: providerConfig + `
+data "forgejo_organization_members" "test" {
+	organization = "non_existent"
+}`,
+				ExpectError: regexp.MustCompile("Organization with name 'non_existent' not found"),
+			},
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	name                      = "test_team"
+	organization_id           = forgejo_organization.test.id
+	includes_all_repositories = false
+	units                     = {
+		code = "read"
+	}
+}
+resource "forgejo_user" "test" {
+	login    = "test_user"
+	email    = "test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+resource "forgejo_team_member" "test" {
+	team_id = forgejo_team.test.id
+	user    = forgejo_user.test.login
+}
+data "forgejo_organization_members" "test" {
+	organization = forgejo_organization.test.name
+	depends_on   = [forgejo_team_member.test]
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.forgejo_organization_members.test", tfjsonpath.New("members"), knownvalue.ListPartial(map[int]knownvalue.Check{
+						0: knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"login":  knownvalue.StringExact("test_user"),
+							"public": knownvalue.Bool(false),
+							"teams":  knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("test_team")}),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -84,7 +85,9 @@ func (r *organizationResource) Metadata(_ context.Context, req resource.Metadata
 // Schema defines the schema for the resource.
 func (r *organizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Forgejo organization resource.",
+		MarkdownDescription: `Forgejo organization resource.
+
+**Note**: Organizations can not be destroyed while they own repositories!`,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
@@ -95,7 +98,7 @@ func (r *organizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the organization. Changing this forces a new resource to be created.",
+				Description: "Name of the organization. Changing this forces a new resource to be created. **Note**: Renaming organizations in place is not supported; the organization cannot be replaced while it still owns repositories.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -398,12 +401,69 @@ func (r *organizationResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	// Use Forgejo client to list organization repositories
+	reps, res, err := listAllPages(func(opts forgejo.ListOptions) ([]*forgejo.Repository, *forgejo.Response, error) {
+		return r.client.ListOrgRepos(
+			data.Name.ValueString(),
+			forgejo.ListOrgReposOptions{ListOptions: opts},
+		)
+	})
+	if err != nil {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			tflog.Error(ctx, "Error", map[string]any{
+				"status": res.Status,
+			})
+
+			switch res.StatusCode {
+			case 404:
+				msg = fmt.Sprintf(
+					"Organization with name %s not found: %s",
+					data.Name.String(),
+					err,
+				)
+			default:
+				msg = fmt.Sprintf(
+					"Unknown error (status %d): %s",
+					res.StatusCode,
+					err,
+				)
+			}
+		}
+		resp.Diagnostics.AddError("Unable to list organization repositories", msg)
+
+		return
+	}
+
+	// Refuse to delete organization owning repositories
+	if len(reps) > 0 {
+		names := make([]string, 0, len(reps))
+		for _, rep := range reps {
+			names = append(names, rep.Name)
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to delete organization",
+			fmt.Sprintf(
+				"Organization with name %s still owns %d repositories: %s. "+
+					"Transfer or delete the repositories before destroying the organization.",
+				data.Name.String(),
+				len(reps),
+				strings.Join(names, ", "),
+			),
+		)
+
+		return
+	}
+
 	tflog.Info(ctx, "Delete organization", map[string]any{
 		"name": data.Name.ValueString(),
 	})
 
 	// Use Forgejo client to delete existing organization
-	res, err := r.client.DeleteOrg(data.Name.ValueString())
+	res, err = r.client.DeleteOrg(data.Name.ValueString())
 	if err != nil {
 		var msg string
 		if res == nil {
@@ -154,3 +154,54 @@ resource "forgejo_organization" "test" {
 		},
 	})
 }
+
+func TestAccOrganizationResourceDeleteWithRepositories(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "tftest"
+}
+resource "forgejo_repository" "test" {
+	owner = forgejo_organization.test.name
+	name  = "tftest"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_organization.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing (organization owns repositories)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	owner = "tftest"
+	name  = "tftest"
+}`,
+				ExpectError: regexp.MustCompile("Organization with name \"tftest\" still owns 1 repositories: tftest"),
+			},
+			// Read testing (restore dependency for destroy order)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "tftest"
+}
+resource "forgejo_repository" "test" {
+	owner = forgejo_organization.test.name
+	name  = "tftest"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_organization.test", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &organizationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationsDataSource{}
+)
+
+// organizationsDataSource is the data source implementation.
+type organizationsDataSource struct {
+	client *forgejo.Client
+}
+
+// organizationsDataSourceModel maps the data source schema data.
+type organizationsDataSourceModel struct {
+	Visibility    types.String `tfsdk:"visibility"`
+	Organizations types.List   `tfsdk:"organizations"`
+}
+
+// Metadata returns the data source type name.
+func (d *organizationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organizations"
+}
+
+// Schema defines the schema for the data source.
+func (d *organizationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo organizations data source.
+
+Lists all organizations of the Forgejo instance.
+
+**Note**: This data source requires administrative privileges!`,
+
+		Attributes: map[string]schema.Attribute{
+			"visibility": schema.StringAttribute{
+				Description: "Only return organizations with this visibility ('public', 'limited' or 'private').",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"public",
+						"limited",
+						"private",
+					),
+				},
+			},
+			"organizations": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: organizationDataSourceAttributes(),
+				},
+				Description: "Organizations matching the filters, sorted by name.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *organizationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *organizationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer un(trace(ctx, "Read users data source"))
+
+	var data organizationsDataSourceModel
+
+	// Read Terraform configuration data into model
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to list organizations
+	orgs, diags := listOrganizations(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Filter organizations and sort them by name for a stable order
+	if !data.Visibility.IsNull() {
+		orgs = slices.DeleteFunc(orgs, func(org *forgejo.Organization) bool {
+			return org.Visibility != data.Visibility.ValueString()
+		})
+	}
+	slices.SortFunc(orgs, func(a, b *forgejo.Organization) int {
+		return strings.Compare(a.UserName, b.UserName)
+	})
+
+	// Map response body to model
+	organizations := make([]organizationDataSourceModel, len(orgs))
+	for i, org := range orgs {
+		organizations[i].from(org)
+	}
+	data.Organizations, diags = types.ListValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: organizationDataSourceModel{}.attributeTypes()},
+		organizations,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewOrganizationsDataSource is a helper function to simplify the provider implementation.
+func NewOrganizationsDataSource() datasource.DataSource {
+	return &organizationsDataSource{}
+}
+
+// listOrganizations is a helper function to list all organizations of the
+// instance.
+func listOrganizations(ctx context.Context, client *forgejo.Client) ([]*forgejo.Organization, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "List organizations")
+
+	// Use Forgejo client to list organizations
+	orgs, res, err := listAllPages(func(opts forgejo.ListOptions) ([]*forgejo.Organization, *forgejo.Response, error) {
+		return client.AdminListOrgs(forgejo.AdminListOrgsOptions{
+			ListOptions: opts,
+		})
+	})
+	if err == nil {
+		return orgs, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 403:
+			msg = fmt.Sprintf("Listing organizations forbidden (administrative privileges required): %s", err)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to list organizations", msg)
+
+	return nil, diags
+}
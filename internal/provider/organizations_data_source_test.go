@@ -0,0 +1,52 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestAccOrganizationsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "public" {
+	name = "tftest_public"
+}
+resource "forgejo_organization" "private" {
+	name       = "tftest_private"
+	visibility = "private"
+}
+data "forgejo_organizations" "all" {
+	depends_on = [forgejo_organization.public, forgejo_organization.private]
+}
+data "forgejo_organizations" "private" {
+	visibility = "private"
+	depends_on = [forgejo_organization.public, forgejo_organization.private]
+}
+output "all" {
+	value = [for o in data.forgejo_organizations.all.organizations : o.name if startswith(o.name, "tftest_")]
+}
+output "private" {
+	value = [for o in data.forgejo_organizations.private.organizations : o.name if startswith(o.name, "tftest_")]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("data.forgejo_organizations.all", plancheck.ResourceActionRead),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("all", knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("tftest_private"), knownvalue.StringExact("tftest_public")})),
+					statecheck.ExpectKnownOutputValue("private", knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("tftest_private")})),
+				},
+			},
+		},
+	})
+}
@@ -5,7 +5,9 @@ import (
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -17,7 +19,11 @@ import (
 )
 
 // Ensure the implementation satisfies the expected interfaces.
-var _ provider.Provider = &forgejoProvider{}
+var (
+	_ provider.Provider                       = &forgejoProvider{}
+	_ provider.ProviderWithActions            = &forgejoProvider{}
+	_ provider.ProviderWithEphemeralResources = &forgejoProvider{}
+)
 
 // forgejoProvider defines the provider implementation.
 type forgejoProvider struct {
@@ -231,27 +237,57 @@ func (p *forgejoProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	// Make the Forgejo client available during DataSource and Resource
-	// type Configure methods.
+	// Make the Forgejo client available during Action, DataSource and
+	// Resource type Configure methods.
+	resp.ActionData = client
 	resp.DataSourceData = client
+	resp.EphemeralResourceData = client
 	resp.ResourceData = client
 }
 
+// Actions defines the actions implemented in the provider.
+func (p *forgejoProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewRepositoryMirrorSyncAction,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *forgejoProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewActionsRunnerRegistrationTokenEphemeralResource,
+	}
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *forgejoProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewActionsRunnerRegistrationTokenDataSource,
+		NewBranchProtectionDataSource,
+		NewBranchProtectionsDataSource,
 		NewCollaboratorDataSource,
+		NewCurrentUserDataSource,
 		NewDeployKeyDataSource,
 		NewGPGKeyDataSource,
+		NewOrganizationActionSecretsDataSource,
 		NewOrganizationActionVariableDataSource,
 		NewOrganizationDataSource,
+		NewOrganizationMembersDataSource,
+		NewOrganizationsDataSource,
 		NewPersonalAccessTokenDataSource,
+		NewRepositoriesDataSource,
+		NewRepositoryActionSecretsDataSource,
 		NewRepositoryActionVariableDataSource,
 		NewRepositoryDataSource,
+		NewRepositoryWebhookDataSource,
+		NewRepositoryWebhooksDataSource,
+		NewServerSettingsDataSource,
 		NewSSHKeyDataSource,
 		NewTeamDataSource,
 		NewTeamMemberDataSource,
+		NewTeamsDataSource,
 		NewUserDataSource,
+		NewUsersDataSource,
 	}
 }
 
@@ -261,19 +297,31 @@ func (p *forgejoProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewCollaboratorResource,
 		NewDeployKeyResource,
 		NewGPGKeyResource,
+		NewOAuth2ApplicationResource,
 		NewOrganizationActionSecretResource,
+		NewOrganizationActionSecretsResource,
 		NewOrganizationActionVariableResource,
+		NewOrganizationActionVariablesResource,
+		NewOrganizationMemberResource,
+		NewOrganizationMemberVisibilityResource,
 		NewOrganizationResource,
 		NewPersonalAccessTokenResource,
 		NewRepositoryActionSecretResource,
+		NewRepositoryActionSecretsResource,
 		NewRepositoryActionVariableResource,
+		NewRepositoryActionVariablesResource,
 		NewRepositoryResource,
 		NewRepositoryWebhookResource,
 		NewSSHKeyResource,
 		NewBranchProtectionResource,
 		NewTeamResource,
 		NewTeamMemberResource,
+		NewTeamMembersResource,
+		NewTeamRepositoryResource,
+		NewUserActionSecretResource,
+		NewUserActionVariableResource,
 		NewUserResource,
+		NewUserEmailResource,
 	}
 }
 
@@ -294,3 +342,24 @@ func trace(ctx context.Context, s string) (context.Context, string) {
 func un(ctx context.Context, s string) {
 	tflog.Trace(ctx, s+" - end")
 }
+
+// listPageSize is the number of items requested per page by listAllPages.
+const listPageSize = 50
+
+// listAllPages is a helper function to fetch all pages of a paginated API
+// endpoint. Pages are requested until an empty page is returned, as the
+// server caps the page size and not all endpoints support page -1.
+func listAllPages[T any](list func(opts forgejo.ListOptions) ([]T, *forgejo.Response, error)) ([]T, *forgejo.Response, error) {
+	var items []T
+
+	for page := 1; ; page++ {
+		pageItems, res, err := list(forgejo.ListOptions{
+			Page:     page,
+			PageSize: listPageSize,
+		})
+		if err != nil || len(pageItems) == 0 {
+			return items, res, err
+		}
+		items = append(items, pageItems...)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+
 	"terraform-provider-forgejo/internal/provider"
 )
 
@@ -59,3 +61,17 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("FORGEJO_PASSWORD must be set for acceptance tests")
 	}
 }
+
+// testAccClient returns a Forgejo client to change resources outside of
+// Terraform during acceptance testing.
+func testAccClient(t *testing.T) *forgejo.Client {
+	client, err := forgejo.NewClient(
+		forgejoTestHost,
+		forgejo.SetToken(os.Getenv("FORGEJO_API_TOKEN")),
+	)
+	if err != nil {
+		t.Fatalf("Unable to create Forgejo client: %s", err)
+	}
+
+	return client
+}
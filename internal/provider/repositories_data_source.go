@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &repositoriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &repositoriesDataSource{}
+)
+
+// repositoriesDataSource is the data source implementation.
+type repositoriesDataSource struct {
+	client *forgejo.Client
+}
+
+// repositoriesDataSourceModel maps the data source schema data.
+type repositoriesDataSourceModel struct {
+	Owner        types.String `tfsdk:"owner"`
+	Topic        types.String `tfsdk:"topic"`
+	Keyword      types.String `tfsdk:"keyword"`
+	Private      types.Bool   `tfsdk:"private"`
+	Archived     types.Bool   `tfsdk:"archived"`
+	Mirror       types.Bool   `tfsdk:"mirror"`
+	Template     types.Bool   `tfsdk:"template"`
+	Fork         types.Bool   `tfsdk:"fork"`
+	Repositories types.List   `tfsdk:"repositories"`
+}
+
+// matches reports whether a repository satisfies the boolean filters.
+func (m *repositoriesDataSourceModel) matches(rep *forgejo.Repository) bool {
+	filters := []struct {
+		filter types.Bool
+		value  bool
+	}{
+		{m.Private, rep.Private},
+		{m.Archived, rep.Archived},
+		{m.Mirror, rep.Mirror},
+		{m.Template, rep.Template},
+		{m.Fork, rep.Fork},
+	}
+	for _, f := range filters {
+		if !f.filter.IsNull() && f.filter.ValueBool() != f.value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Metadata returns the data source type name.
+func (d *repositoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repositories"
+}
+
+// Schema defines the schema for the data source.
+func (d *repositoriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo repositories data source.
+
+Searches all repositories visible to the authenticated user. Filters are optional and combined; omitted filters match every repository.`,
+
+		Attributes: map[string]schema.Attribute{
+			"owner": schema.StringAttribute{
+				Description: "Owner of the repositories (user or organization).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"topic": schema.StringAttribute{
+				MarkdownDescription: "Topic of the repositories. **Note**: Conflicts with `keyword`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("keyword"),
+					}...),
+				},
+			},
+			"keyword": schema.StringAttribute{
+				MarkdownDescription: "Keyword to search for in the name and description of the repositories. **Note**: Conflicts with `topic`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("topic"),
+					}...),
+				},
+			},
+			"private": schema.BoolAttribute{
+				Description: "Only return private (true) or public (false) repositories.",
+				Optional:    true,
+			},
+			"archived": schema.BoolAttribute{
+				Description: "Only return archived (true) or non-archived (false) repositories.",
+				Optional:    true,
+			},
+			"mirror": schema.BoolAttribute{
+				Description: "Only return mirror (true) or non-mirror (false) repositories.",
+				Optional:    true,
+			},
+			"template": schema.BoolAttribute{
+				Description: "Only return template (true) or non-template (false) repositories.",
+				Optional:    true,
+			},
+			"fork": schema.BoolAttribute{
+				Description: "Only return fork (true) or non-fork (false) repositories.",
+				Optional:    true,
+			},
+			"repositories": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: repositoryDataSourceAttributes(),
+				},
+				Description: "Repositories matching the filters, sorted by full name.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *repositoriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *repositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer un(trace(ctx, "Read repositories data source"))
+
+	var data repositoriesDataSourceModel
+
+	// Read Terraform configuration data into model
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate search query from model
+	query := url.Values{}
+	if !data.Owner.IsNull() {
+		// Use Forgejo client to get owner (user or organization)
+		usr, diags := getUserByName(ctx, d.client, data.Owner.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		query.Set("uid", strconv.FormatInt(usr.ID, 10))
+		query.Set("exclusive", "true")
+	}
+	if !data.Topic.IsNull() {
+		query.Set("q", data.Topic.ValueString())
+		query.Set("topic", "true")
+	}
+	if !data.Keyword.IsNull() {
+		query.Set("q", data.Keyword.ValueString())
+		query.Set("includeDesc", "true")
+	}
+	if !data.Private.IsNull() {
+		query.Set("is_private", strconv.FormatBool(data.Private.ValueBool()))
+	}
+	if !data.Archived.IsNull() {
+		query.Set("archived", strconv.FormatBool(data.Archived.ValueBool()))
+	}
+	if !data.Template.IsNull() {
+		query.Set("template", strconv.FormatBool(data.Template.ValueBool()))
+	}
+	if data.Fork.ValueBool() {
+		query.Set("mode", string(forgejo.RepoTypeFork))
+	} else if data.Mirror.ValueBool() {
+		query.Set("mode", string(forgejo.RepoTypeMirror))
+	}
+
+	// Use Forgejo client to search repositories
+	reps, diags := searchRepositories(ctx, d.client, query)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The search API cannot express every filter (e.g. non-forks only),
+	// so apply all boolean filters to the results as well
+	reps = slices.DeleteFunc(reps, func(rep *forgejo.Repository) bool {
+		return !data.matches(rep)
+	})
+
+	// Sort repositories by full name for a stable order
+	slices.SortFunc(reps, func(a, b *forgejo.Repository) int {
+		return strings.Compare(a.FullName, b.FullName)
+	})
+
+	// Map response body to model
+	repositories := make([]repositoryDataSourceModel, len(reps))
+	for i, rep := range reps {
+		diags = repositories[i].from(ctx, rep)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	data.Repositories, diags = types.ListValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: repositoryDataSourceModel{}.attributeTypes()},
+		repositories,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewRepositoriesDataSource is a helper function to simplify the provider implementation.
+func NewRepositoriesDataSource() datasource.DataSource {
+	return &repositoriesDataSource{}
+}
+
+// searchRepositories is a helper function to search repositories, following
+// all result pages.
+func searchRepositories(ctx context.Context, client *forgejo.Client, query url.Values) ([]*forgejo.Repository, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Search repositories", map[string]any{
+		"query": query.Encode(),
+	})
+
+	// Use Forgejo client to search repositories. The raw query is used
+	// because the SDK encodes optional boolean filters incorrectly.
+	reps, res, err := listAllPages(func(opts forgejo.ListOptions) ([]*forgejo.Repository, *forgejo.Response, error) {
+		query.Set("page", strconv.Itoa(opts.Page))
+		query.Set("limit", strconv.Itoa(opts.PageSize))
+
+		return client.SearchRepos(forgejo.SearchRepoOptions{
+			RawQuery: query.Encode(),
+		})
+	})
+	if err == nil {
+		return reps, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 422:
+			msg = fmt.Sprintf("Input validation error: %s", err)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to search repositories", msg)
+
+	return nil, diags
+}
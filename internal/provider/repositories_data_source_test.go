@@ -0,0 +1,124 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccRepositoriesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (non-existent owner)
+			{
+				Config: providerConfig + `
+data "forgejo_repositories" "test" {
+	owner = "non_existent"
+}`,
+				ExpectError: regexp.MustCompile("User with name 'non_existent' not found"),
+			},
+			// Read testing (invalid configuration)
+			{
+				Config: providerConfig + `
+data "forgejo_repositories" "test" {
+	topic   = "terraform"
+	keyword = "terraform"
+}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_repository" "private" {
+	owner       = forgejo_organization.test.name
+	name        = "tftest_private"
+	description = "Private test repository"
+	private     = true
+}
+resource "forgejo_repository" "template" {
+	owner       = forgejo_organization.test.name
+	name        = "tftest_template"
+	description = "Template test repository"
+	template    = true
+}
+data "forgejo_repositories" "owner" {
+	owner      = forgejo_organization.test.name
+	depends_on = [forgejo_repository.private, forgejo_repository.template]
+}
+data "forgejo_repositories" "private" {
+	owner      = forgejo_organization.test.name
+	private    = true
+	depends_on = [forgejo_repository.private, forgejo_repository.template]
+}
+data "forgejo_repositories" "template" {
+	owner      = forgejo_organization.test.name
+	template   = true
+	depends_on = [forgejo_repository.private, forgejo_repository.template]
+}
+data "forgejo_repositories" "keyword" {
+	owner      = forgejo_organization.test.name
+	keyword    = "private"
+	depends_on = [forgejo_repository.private, forgejo_repository.template]
+}
+data "forgejo_repositories" "fork" {
+	owner      = forgejo_organization.test.name
+	fork       = true
+	depends_on = [forgejo_repository.private, forgejo_repository.template]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("data.forgejo_repositories.owner", plancheck.ResourceActionRead),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.forgejo_repositories.owner", tfjsonpath.New("repositories"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"full_name":   knownvalue.StringExact("test_org/tftest_private"),
+							"owner":       knownvalue.StringExact("test_org"),
+							"name":        knownvalue.StringExact("tftest_private"),
+							"description": knownvalue.StringExact("Private test repository"),
+							"private":     knownvalue.Bool(true),
+							"template":    knownvalue.Bool(false),
+							"permissions": knownvalue.NotNull(),
+						}),
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"full_name":   knownvalue.StringExact("test_org/tftest_template"),
+							"owner":       knownvalue.StringExact("test_org"),
+							"name":        knownvalue.StringExact("tftest_template"),
+							"description": knownvalue.StringExact("Template test repository"),
+							"private":     knownvalue.Bool(false),
+							"template":    knownvalue.Bool(true),
+							"permissions": knownvalue.NotNull(),
+						}),
+					})),
+					statecheck.ExpectKnownValue("data.forgejo_repositories.private", tfjsonpath.New("repositories"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"full_name": knownvalue.StringExact("test_org/tftest_private"),
+						}),
+					})),
+					statecheck.ExpectKnownValue("data.forgejo_repositories.template", tfjsonpath.New("repositories"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"full_name": knownvalue.StringExact("test_org/tftest_template"),
+						}),
+					})),
+					statecheck.ExpectKnownValue("data.forgejo_repositories.keyword", tfjsonpath.New("repositories"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"full_name": knownvalue.StringExact("test_org/tftest_private"),
+						}),
+					})),
+					statecheck.ExpectKnownValue("data.forgejo_repositories.fork", tfjsonpath.New("repositories"), knownvalue.ListSizeExact(0)),
+				},
+			},
+		},
+	})
+}
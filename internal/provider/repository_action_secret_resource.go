@@ -71,7 +71,9 @@ func (r *repositoryActionSecretResource) Metadata(_ context.Context, req resourc
 // Schema defines the schema for the resource.
 func (r *repositoryActionSecretResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Forgejo repository action secret resource.",
+		MarkdownDescription: `Forgejo repository action secret resource.
+
+**Note**: Forgejo does not return secret data. Secrets recreated outside of Terraform are detected by their ` + "`created_at`" + ` timestamp and updated with the configured data.`,
 
 		Attributes: map[string]schema.Attribute{
 			"repository_id": schema.Int64Attribute{
@@ -280,6 +282,23 @@ func (r *repositoryActionSecretResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	/*
+	 * Forgejo never returns the secret data, so changes made outside of
+	 * Terraform can only be detected by a differing creation time. In that
+	 * case, the data is cleared from the state to force an update.
+	 */
+	if !data.CreatedAt.IsNull() &&
+		data.CreatedAt.ValueString() != secret.Created.Format(time.RFC3339) {
+		tflog.Warn(ctx, "Repository action secret changed outside of Terraform", map[string]any{
+			"user":       repo.Owner.ValueString(),
+			"repo":       repo.Name.ValueString(),
+			"name":       data.Name.ValueString(),
+			"created_at": secret.Created.Format(time.RFC3339),
+		})
+
+		data.Data = types.StringNull()
+	}
+
 	// Map response body to model
 	data.from(secret)
 
@@ -468,51 +487,9 @@ func NewRepositoryActionSecretResource() resource.Resource {
 
 // getSecret returns the secret with the given name from the repository.
 func (r *repositoryActionSecretResource) getSecret(ctx context.Context, owner, repo, name string) (*forgejo.Secret, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	tflog.Info(ctx, "List repository action secrets", map[string]any{
-		"owner": owner,
-		"repo":  repo,
-		"name":  name,
-	})
-
 	// Use Forgejo client to list repository action secrets
-	secrets, res, err := r.client.ListRepoActionSecret(
-		owner,
-		repo,
-		forgejo.ListRepoActionSecretOption{
-			ListOptions: forgejo.ListOptions{
-				Page: -1,
-			},
-		},
-	)
-	if err != nil {
-		var msg string
-		if res == nil {
-			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
-		} else {
-			tflog.Error(ctx, "Error", map[string]any{
-				"status": res.Status,
-			})
-
-			switch res.StatusCode {
-			case 404:
-				msg = fmt.Sprintf(
-					"Action secrets with owner '%s' and repo '%s' not found: %s",
-					owner,
-					repo,
-					err,
-				)
-			default:
-				msg = fmt.Sprintf(
-					"Unknown error (status %d): %s",
-					res.StatusCode,
-					err,
-				)
-			}
-		}
-		diags.AddError("Unable to list repository action secrets", msg)
-
+	secrets, diags := listRepositoryActionSecrets(ctx, r.client, owner, repo)
+	if diags.HasError() {
 		return nil, diags
 	}
 
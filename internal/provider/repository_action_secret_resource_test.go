@@ -3,12 +3,15 @@ package provider_test
 import (
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
 )
 
 func TestAccRepositoryActionSecretResource(t *testing.T) {
@@ -95,6 +98,46 @@ resource "forgejo_repository_action_secret" "test" {
 					statecheck.ExpectKnownValue("forgejo_repository_action_secret.test", tfjsonpath.New("created_at"), knownvalue.NotNull()),
 				},
 			},
+			// Update and Read testing (secret recreated outside of Terraform)
+			{
+				PreConfig: func() {
+					client := testAccClient(t)
+
+					// Creation times have a resolution of one second
+					time.Sleep(time.Second)
+
+					_, err := client.DeleteRepoActionSecret(forgejoTestUser, "test_repo", "my_new_secret")
+					if err != nil {
+						t.Fatalf("Unable to delete repository action secret: %s", err)
+					}
+					_, err = client.CreateRepoActionSecret(forgejoTestUser, "test_repo", forgejo.CreateSecretOption{
+						Name: "my_new_secret",
+						Data: "out_of_band_value",
+					})
+					if err != nil {
+						t.Fatalf("Unable to create repository action secret: %s", err)
+					}
+				},
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name        = "test_repo"
+}
+resource "forgejo_repository_action_secret" "test" {
+	repository_id = forgejo_repository.test.id
+	name          = "my_new_secret"
+	data          = "my_new_secret_value"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository_action_secret.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository_action_secret.test", tfjsonpath.New("name"), knownvalue.StringExact("my_new_secret")),
+					statecheck.ExpectSensitiveValue("forgejo_repository_action_secret.test", tfjsonpath.New("data")),
+					statecheck.ExpectKnownValue("forgejo_repository_action_secret.test", tfjsonpath.New("created_at"), knownvalue.NotNull()),
+				},
+			},
 			// Recreate and Read testing (long name)
 			{
 				Config: providerConfig + `
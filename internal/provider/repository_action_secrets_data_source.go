@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &repositoryActionSecretsDataSource{}
+	_ datasource.DataSourceWithConfigure = &repositoryActionSecretsDataSource{}
+)
+
+// repositoryActionSecretsDataSource is the data source implementation.
+type repositoryActionSecretsDataSource struct {
+	client *forgejo.Client
+}
+
+// repositoryActionSecretsDataSourceModel maps the data source schema data.
+type repositoryActionSecretsDataSourceModel struct {
+	RepositoryID types.Int64 `tfsdk:"repository_id"`
+	Secrets      types.List  `tfsdk:"secrets"`
+}
+
+// actionSecretsDataSourceSecret maps a single action secret. Forgejo never
+// returns secret data, so only the name and creation time are available.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#Secret
+type actionSecretsDataSourceSecret struct {
+	Name      types.String `tfsdk:"name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (m actionSecretsDataSourceSecret) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":       types.StringType,
+		"created_at": types.StringType,
+	}
+}
+
+// from is a helper function to load an API struct into Terraform data model.
+func (m *actionSecretsDataSourceSecret) from(s *forgejo.Secret) {
+	m.Name = types.StringValue(s.Name)
+	m.CreatedAt = types.StringValue(s.Created.Format(time.RFC3339))
+}
+
+// actionSecretsDataSourceAttribute returns the computed secrets attribute
+// shared by the repository and organization action secrets data sources.
+func actionSecretsDataSourceAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "Name of the secret.",
+					Computed:    true,
+				},
+				"created_at": schema.StringAttribute{
+					Description: "Time at which the secret was created.",
+					Computed:    true,
+				},
+			},
+		},
+		Description: description,
+		Computed:    true,
+	}
+}
+
+// actionSecretsValueFrom is a helper function to convert API secrets into a
+// Terraform list value, sorted by name.
+func actionSecretsValueFrom(ctx context.Context, secrets []*forgejo.Secret) (types.List, diag.Diagnostics) {
+	// Sort secrets by name for a stable order
+	slices.SortFunc(secrets, func(a, b *forgejo.Secret) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	values := make([]actionSecretsDataSourceSecret, len(secrets))
+	for i, s := range secrets {
+		values[i].from(s)
+	}
+
+	return types.ListValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: actionSecretsDataSourceSecret{}.attributeTypes()},
+		values,
+	)
+}
+
+// Metadata returns the data source type name.
+func (d *repositoryActionSecretsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_action_secrets"
+}
+
+// Schema defines the schema for the data source.
+func (d *repositoryActionSecretsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo repository action secrets data source.
+
+Lists the names and creation times of all action secrets of a repository. Secret values are never returned by Forgejo.`,
+
+		Attributes: map[string]schema.Attribute{
+			"repository_id": schema.Int64Attribute{
+				Description: "Numeric identifier of the repository.",
+				Required:    true,
+			},
+			"secrets": actionSecretsDataSourceAttribute("Action secrets of the repository, sorted by name."),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *repositoryActionSecretsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *repositoryActionSecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer un(trace(ctx, "Read repository action secrets data source"))
+
+	var data repositoryActionSecretsDataSourceModel
+
+	// Read Terraform configuration data into model
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository by id
+	rep, diags := getRepositoryByID(
+		ctx,
+		d.client,
+		data.RepositoryID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to list repository action secrets
+	secrets, diags := listRepositoryActionSecrets(
+		ctx,
+		d.client,
+		rep.Owner.UserName,
+		rep.Name,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	data.Secrets, diags = actionSecretsValueFrom(ctx, secrets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewRepositoryActionSecretsDataSource is a helper function to simplify the provider implementation.
+func NewRepositoryActionSecretsDataSource() datasource.DataSource {
+	return &repositoryActionSecretsDataSource{}
+}
@@ -0,0 +1,77 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccRepositoryActionSecretsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (non-existent repository)
+			{
+				Config: providerConfig + `
+data "forgejo_repository_action_secrets" "test" {
+	repository_id = -1
+}`,
+				ExpectError: regexp.MustCompile("Repository with ID -1 not found"),
+			},
+			// Read testing (no secrets)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+data "forgejo_repository_action_secrets" "test" {
+	repository_id = forgejo_repository.test.id
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.forgejo_repository_action_secrets.test", tfjsonpath.New("secrets"), knownvalue.ListSizeExact(0)),
+				},
+			},
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+resource "forgejo_repository_action_secrets" "test" {
+	repository_id = forgejo_repository.test.id
+	secrets = {
+		my_secret       = { data = "my_secret_value" }
+		my_other_secret = { data = "my_other_secret_value" }
+	}
+}
+data "forgejo_repository_action_secrets" "test" {
+	repository_id = forgejo_repository.test.id
+	depends_on    = [forgejo_repository_action_secrets.test]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("data.forgejo_repository_action_secrets.test", plancheck.ResourceActionRead),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.forgejo_repository_action_secrets.test", tfjsonpath.New("secrets"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"name":       knownvalue.StringExact("MY_OTHER_SECRET"),
+							"created_at": knownvalue.NotNull(),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"name":       knownvalue.StringExact("MY_SECRET"),
+							"created_at": knownvalue.NotNull(),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
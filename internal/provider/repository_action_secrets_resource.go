@@ -0,0 +1,622 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &repositoryActionSecretsResource{}
+	_ resource.ResourceWithConfigure = &repositoryActionSecretsResource{}
+)
+
+// repositoryActionSecretsResource is the resource implementation.
+type repositoryActionSecretsResource struct {
+	client *forgejo.Client
+}
+
+// repositoryActionSecretsResourceModel maps the resource schema data.
+type repositoryActionSecretsResourceModel struct {
+	RepositoryID    types.Int64 `tfsdk:"repository_id"`
+	Secrets         types.Map   `tfsdk:"secrets"`
+	DeleteUnmanaged types.Bool  `tfsdk:"delete_unmanaged"`
+}
+
+// actionSecretsResourceSecret maps the secret schema data.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#CreateSecretOption
+type actionSecretsResourceSecret struct {
+	Data types.String `tfsdk:"data"`
+}
+
+func (m actionSecretsResourceSecret) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"data": types.StringType,
+	}
+}
+
+// from is a helper function to load API data into Terraform data model.
+func (m *repositoryActionSecretsResourceModel) from(ctx context.Context, secrets []*forgejo.Secret) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Secrets, diags = actionSecretsValue(
+		ctx,
+		m.Secrets,
+		secrets,
+		m.DeleteUnmanaged.ValueBool(),
+	)
+
+	return diags
+}
+
+// Metadata returns the resource type name.
+func (r *repositoryActionSecretsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_action_secrets"
+}
+
+// Schema defines the schema for the resource.
+func (r *repositoryActionSecretsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo repository action secrets resource.
+
+This resource manages multiple action secrets of a repository at once. Secrets not listed in ` + "`secrets`" + ` are left untouched, unless ` + "`delete_unmanaged`" + ` is enabled.
+
+**Note**: Do not combine this resource with ` + "`forgejo_repository_action_secret`" + ` resources for the same repository, as they will conflict with each other!`,
+
+		Attributes: map[string]schema.Attribute{
+			"repository_id": schema.Int64Attribute{
+				Description: "Numeric identifier of the repository. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"secrets": schema.MapNestedAttribute{
+				Description: "Map of secret names to secrets.",
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthBetween(1, 255)),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"data": schema.StringAttribute{
+							// Write-only attribute
+							Description: "Data of the secret.",
+							Required:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"delete_unmanaged": schema.BoolAttribute{
+				// Provider behavior flag
+				MarkdownDescription: "Delete secrets of the repository which are not listed in `secrets`?",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *repositoryActionSecretsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *repositoryActionSecretsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer un(trace(ctx, "Create repository action secrets resource"))
+
+	var (
+		repo repositoryResourceModel
+		data repositoryActionSecretsResourceModel
+	)
+
+	// Read Terraform plan data into the model
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository
+	rep, diags := getRepositoryByID(
+		ctx,
+		r.client,
+		data.RepositoryID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	repo.from(rep)
+
+	// Use Forgejo client to set repository action secrets
+	diags = r.setSecrets(
+		ctx,
+		repo.Owner.ValueString(),
+		repo.Name.ValueString(),
+		nil,
+		&data,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *repositoryActionSecretsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer un(trace(ctx, "Read repository action secrets resource"))
+
+	var (
+		repo repositoryResourceModel
+		data repositoryActionSecretsResourceModel
+	)
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository
+	rep, diags := getRepositoryByID(
+		ctx,
+		r.client,
+		data.RepositoryID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	repo.from(rep)
+
+	// Use Forgejo client to list repository action secrets
+	secrets, diags := listRepositoryActionSecrets(
+		ctx,
+		r.client,
+		repo.Owner.ValueString(),
+		repo.Name.ValueString(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	diags = data.from(ctx, secrets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *repositoryActionSecretsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer un(trace(ctx, "Update repository action secrets resource"))
+
+	var (
+		state repositoryActionSecretsResourceModel
+		plan  repositoryActionSecretsResourceModel
+		repo  repositoryResourceModel
+		prior map[string]actionSecretsResourceSecret
+	)
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform plan data into the model
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = state.Secrets.ElementsAs(ctx, &prior, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository
+	rep, diags := getRepositoryByID(
+		ctx,
+		r.client,
+		plan.RepositoryID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	repo.from(rep)
+
+	// Use Forgejo client to set repository action secrets
+	diags = r.setSecrets(
+		ctx,
+		repo.Owner.ValueString(),
+		repo.Name.ValueString(),
+		prior,
+		&plan,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *repositoryActionSecretsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer un(trace(ctx, "Delete repository action secrets resource"))
+
+	var (
+		repo    repositoryResourceModel
+		data    repositoryActionSecretsResourceModel
+		secrets map[string]actionSecretsResourceSecret
+	)
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = data.Secrets.ElementsAs(ctx, &secrets, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository
+	rep, diags := getRepositoryByID(
+		ctx,
+		r.client,
+		data.RepositoryID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	repo.from(rep)
+
+	// Use Forgejo client to delete repository action secrets
+	for _, name := range slices.Sorted(maps.Keys(secrets)) {
+		diags = r.deleteSecret(
+			ctx,
+			repo.Owner.ValueString(),
+			repo.Name.ValueString(),
+			name,
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// NewRepositoryActionSecretsResource is a helper function to simplify the provider implementation.
+func NewRepositoryActionSecretsResource() resource.Resource {
+	return &repositoryActionSecretsResource{}
+}
+
+// setSecrets reconciles the action secrets of a repository with the
+// Terraform data model by creating missing, updating changed and deleting
+// removed secrets. Secrets not contained in the prior state are only
+// deleted if the delete_unmanaged flag is set.
+func (r *repositoryActionSecretsResource) setSecrets(ctx context.Context, owner, repo string, prior map[string]actionSecretsResourceSecret, data *repositoryActionSecretsResourceModel) diag.Diagnostics {
+	var desired map[string]actionSecretsResourceSecret
+
+	diags := data.Secrets.ElementsAs(ctx, &desired, false)
+	if diags.HasError() {
+		return diags
+	}
+	names := slices.Sorted(maps.Keys(desired))
+	priorNames := slices.Collect(maps.Keys(prior))
+
+	// Use Forgejo client to list current repository action secrets
+	current, diags := listRepositoryActionSecrets(ctx, r.client, owner, repo)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Create missing and update changed secrets
+	for _, name := range names {
+		equalName := func(n string) bool {
+			return strings.EqualFold(n, name)
+		}
+		exists := slices.ContainsFunc(current, func(s *forgejo.Secret) bool {
+			return equalName(s.Name)
+		})
+		idx := slices.IndexFunc(priorNames, equalName)
+		if exists && idx != -1 && prior[priorNames[idx]].Data.Equal(desired[name].Data) {
+			continue
+		}
+
+		diags.Append(r.createSecret(ctx, owner, repo, name, desired[name].Data.ValueString())...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// Delete removed and unmanaged secrets
+	for _, secret := range current {
+		equalName := func(n string) bool {
+			return strings.EqualFold(n, secret.Name)
+		}
+		if slices.ContainsFunc(names, equalName) {
+			continue
+		}
+		if !data.DeleteUnmanaged.ValueBool() && !slices.ContainsFunc(priorNames, equalName) {
+			continue
+		}
+
+		diags.Append(r.deleteSecret(ctx, owner, repo, secret.Name)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// createSecret creates or updates an action secret in the repository.
+func (r *repositoryActionSecretsResource) createSecret(ctx context.Context, owner, repo, name, data string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Create repository action secret", map[string]any{
+		"owner": owner,
+		"repo":  repo,
+		"name":  name,
+		"data":  strings.Repeat("*", len(data)),
+	})
+
+	// Generate API request body
+	opts := forgejo.CreateSecretOption{
+		Name: name,
+		Data: data,
+	}
+
+	// Validate API request body
+	err := opts.Validate()
+	if err != nil {
+		diags.AddError("Input validation error", err.Error())
+
+		return diags
+	}
+
+	// Use Forgejo client to create or update repository action secret
+	res, err := r.client.CreateRepoActionSecret(owner, repo, opts)
+	if err == nil {
+		return diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 400:
+			msg = fmt.Sprintf("Bad request: %s", err)
+		case 404:
+			msg = fmt.Sprintf(
+				"Repository with owner '%s' and name '%s' not found: %s",
+				owner,
+				repo,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to create repository action secret", msg)
+
+	return diags
+}
+
+// deleteSecret deletes an action secret of the repository.
+func (r *repositoryActionSecretsResource) deleteSecret(ctx context.Context, owner, repo, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Delete repository action secret", map[string]any{
+		"owner": owner,
+		"repo":  repo,
+		"name":  name,
+	})
+
+	// Use Forgejo client to delete existing repository action secret
+	res, err := r.client.DeleteRepoActionSecret(owner, repo, name)
+	if err == nil {
+		return diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 400:
+			msg = fmt.Sprintf("Bad request: %s", err)
+		case 404:
+			msg = fmt.Sprintf(
+				"Action secret with owner '%s', repo '%s' and name '%s' not found: %s",
+				owner,
+				repo,
+				name,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to delete repository action secret", msg)
+
+	return diags
+}
+
+// listRepositoryActionSecrets is a helper function to fetch all action
+// secrets of a repository.
+func listRepositoryActionSecrets(ctx context.Context, client *forgejo.Client, owner, repo string) ([]*forgejo.Secret, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "List repository action secrets", map[string]any{
+		"owner": owner,
+		"repo":  repo,
+	})
+
+	// Use Forgejo client to list repository action secrets
+	secrets, res, err := listAllPages(func(opts forgejo.ListOptions) ([]*forgejo.Secret, *forgejo.Response, error) {
+		return client.ListRepoActionSecret(owner, repo, forgejo.ListRepoActionSecretOption{
+			ListOptions: opts,
+		})
+	})
+	if err == nil {
+		return secrets, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 404:
+			msg = fmt.Sprintf(
+				"Repository with owner '%s' and name '%s' not found: %s",
+				owner,
+				repo,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to list repository action secrets", msg)
+
+	return nil, diags
+}
+
+// actionSecretsValue is a helper function to convert API secrets into a
+// Terraform map value. As Forgejo never returns secret data, the known data
+// and name spelling are kept for secrets present in the known map. Secrets
+// not present in the known map are only included, without data, if
+// unmanaged is set.
+func actionSecretsValue(ctx context.Context, known types.Map, secrets []*forgejo.Secret, unmanaged bool) (types.Map, diag.Diagnostics) {
+	var prior map[string]actionSecretsResourceSecret
+
+	diags := known.ElementsAs(ctx, &prior, false)
+	if diags.HasError() {
+		return known, diags
+	}
+	keys := slices.Collect(maps.Keys(prior))
+
+	values := make(map[string]actionSecretsResourceSecret, len(secrets))
+	for _, secret := range secrets {
+		idx := slices.IndexFunc(keys, func(k string) bool {
+			return strings.EqualFold(k, secret.Name)
+		})
+
+		switch {
+		case idx != -1:
+			values[keys[idx]] = prior[keys[idx]]
+		case unmanaged:
+			values[secret.Name] = actionSecretsResourceSecret{
+				Data: types.StringNull(),
+			}
+		}
+	}
+
+	return types.MapValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: actionSecretsResourceSecret{}.attributeTypes()},
+		values,
+	)
+}
@@ -0,0 +1,107 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccRepositoryActionSecretsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing (non-existent repository)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository_action_secrets" "test" {
+	repository_id = -1
+	secrets = {
+		my_secret = { data = "my_secret_value" }
+	}
+}`,
+				ExpectError: regexp.MustCompile("Repository with ID -1 not found"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+resource "forgejo_repository_action_secrets" "test" {
+	repository_id = forgejo_repository.test.id
+	secrets = {
+		my_secret       = { data = "my_secret_value" }
+		my_other_secret = { data = "my_other_secret_value" }
+	}
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository_action_secrets.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository_action_secrets.test", tfjsonpath.New("repository_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("forgejo_repository_action_secrets.test", tfjsonpath.New("secrets"), knownvalue.MapSizeExact(2)),
+					statecheck.ExpectSensitiveValue("forgejo_repository_action_secrets.test", tfjsonpath.New("secrets").AtMapKey("my_secret").AtMapKey("data")),
+					statecheck.ExpectSensitiveValue("forgejo_repository_action_secrets.test", tfjsonpath.New("secrets").AtMapKey("my_other_secret").AtMapKey("data")),
+					statecheck.ExpectKnownValue("forgejo_repository_action_secrets.test", tfjsonpath.New("delete_unmanaged"), knownvalue.Bool(false)),
+				},
+			},
+			// Update and Read testing (add, change and remove secrets)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+resource "forgejo_repository_action_secrets" "test" {
+	repository_id = forgejo_repository.test.id
+	secrets = {
+		my_other_secret = { data = "my_new_secret_value" }
+		my_new_secret   = { data = "my_secret_value" }
+	}
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository_action_secrets.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository_action_secrets.test", tfjsonpath.New("secrets"), knownvalue.MapSizeExact(2)),
+					statecheck.ExpectSensitiveValue("forgejo_repository_action_secrets.test", tfjsonpath.New("secrets").AtMapKey("my_other_secret").AtMapKey("data")),
+					statecheck.ExpectSensitiveValue("forgejo_repository_action_secrets.test", tfjsonpath.New("secrets").AtMapKey("my_new_secret").AtMapKey("data")),
+				},
+			},
+			// Update and Read testing (delete unmanaged secrets)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+resource "forgejo_repository_action_secrets" "test" {
+	repository_id    = forgejo_repository.test.id
+	delete_unmanaged = true
+	secrets = {
+		my_other_secret = { data = "my_new_secret_value" }
+		my_new_secret   = { data = "my_secret_value" }
+	}
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository_action_secrets.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository_action_secrets.test", tfjsonpath.New("secrets"), knownvalue.MapSizeExact(2)),
+					statecheck.ExpectKnownValue("forgejo_repository_action_secrets.test", tfjsonpath.New("delete_unmanaged"), knownvalue.Bool(true)),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
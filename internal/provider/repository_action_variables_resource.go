@@ -0,0 +1,663 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &repositoryActionVariablesResource{}
+	_ resource.ResourceWithConfigure = &repositoryActionVariablesResource{}
+)
+
+// repositoryActionVariablesResource is the resource implementation.
+type repositoryActionVariablesResource struct {
+	client *forgejo.Client
+}
+
+// repositoryActionVariablesResourceModel maps the resource schema data.
+type repositoryActionVariablesResourceModel struct {
+	RepositoryID    types.Int64 `tfsdk:"repository_id"`
+	Variables       types.Map   `tfsdk:"variables"`
+	DeleteUnmanaged types.Bool  `tfsdk:"delete_unmanaged"`
+}
+
+// from is a helper function to load API data into Terraform data model.
+func (m *repositoryActionVariablesResourceModel) from(ctx context.Context, variables []*forgejo.ActionVariable) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Variables, diags = actionVariablesValue(
+		ctx,
+		m.Variables,
+		variables,
+		m.DeleteUnmanaged.ValueBool(),
+	)
+
+	return diags
+}
+
+// Metadata returns the resource type name.
+func (r *repositoryActionVariablesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_action_variables"
+}
+
+// Schema defines the schema for the resource.
+func (r *repositoryActionVariablesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo repository action variables resource.
+
+This resource manages multiple action variables of a repository at once. Variables not listed in ` + "`variables`" + ` are left untouched, unless ` + "`delete_unmanaged`" + ` is enabled.
+
+**Note**: Do not combine this resource with ` + "`forgejo_repository_action_variable`" + ` resources for the same repository, as they will conflict with each other!`,
+
+		Attributes: map[string]schema.Attribute{
+			"repository_id": schema.Int64Attribute{
+				Description: "Numeric identifier of the repository. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"variables": schema.MapAttribute{
+				Description: "Map of variable names to variable data.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.LengthBetween(1, 255)),
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"delete_unmanaged": schema.BoolAttribute{
+				// Provider behavior flag
+				MarkdownDescription: "Delete variables of the repository which are not listed in `variables`?",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *repositoryActionVariablesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *repositoryActionVariablesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer un(trace(ctx, "Create repository action variables resource"))
+
+	var (
+		repo repositoryResourceModel
+		data repositoryActionVariablesResourceModel
+	)
+
+	// Read Terraform plan data into model
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository
+	rep, diags := getRepositoryByID(
+		ctx,
+		r.client,
+		data.RepositoryID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	repo.from(rep)
+
+	// Use Forgejo client to set repository action variables
+	diags = r.setVariables(
+		ctx,
+		repo.Owner.ValueString(),
+		repo.Name.ValueString(),
+		nil,
+		&data,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *repositoryActionVariablesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer un(trace(ctx, "Read repository action variables resource"))
+
+	var (
+		repo repositoryResourceModel
+		data repositoryActionVariablesResourceModel
+	)
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository
+	rep, diags := getRepositoryByID(
+		ctx,
+		r.client,
+		data.RepositoryID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	repo.from(rep)
+
+	// Use Forgejo client to list repository action variables
+	variables, diags := listRepositoryActionVariables(
+		ctx,
+		r.client,
+		repo.Owner.ValueString(),
+		repo.Name.ValueString(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	diags = data.from(ctx, variables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *repositoryActionVariablesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer un(trace(ctx, "Update repository action variables resource"))
+
+	var (
+		state repositoryActionVariablesResourceModel
+		plan  repositoryActionVariablesResourceModel
+		repo  repositoryResourceModel
+		prior map[string]string
+	)
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform plan data into model
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = state.Variables.ElementsAs(ctx, &prior, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository
+	rep, diags := getRepositoryByID(
+		ctx,
+		r.client,
+		plan.RepositoryID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	repo.from(rep)
+
+	// Use Forgejo client to set repository action variables
+	diags = r.setVariables(
+		ctx,
+		repo.Owner.ValueString(),
+		repo.Name.ValueString(),
+		slices.Collect(maps.Keys(prior)),
+		&plan,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *repositoryActionVariablesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer un(trace(ctx, "Delete repository action variables resource"))
+
+	var (
+		repo      repositoryResourceModel
+		data      repositoryActionVariablesResourceModel
+		variables map[string]string
+	)
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = data.Variables.ElementsAs(ctx, &variables, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository
+	rep, diags := getRepositoryByID(
+		ctx,
+		r.client,
+		data.RepositoryID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	repo.from(rep)
+
+	// Use Forgejo client to delete repository action variables
+	for _, name := range slices.Sorted(maps.Keys(variables)) {
+		diags = r.deleteVariable(
+			ctx,
+			repo.Owner.ValueString(),
+			repo.Name.ValueString(),
+			name,
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// NewRepositoryActionVariablesResource is a helper function to simplify the provider implementation.
+func NewRepositoryActionVariablesResource() resource.Resource {
+	return &repositoryActionVariablesResource{}
+}
+
+// setVariables reconciles the action variables of a repository with the
+// Terraform data model by creating missing, updating changed and deleting
+// removed variables. Variables not contained in the prior state are only
+// deleted if the delete_unmanaged flag is set.
+func (r *repositoryActionVariablesResource) setVariables(ctx context.Context, owner, repo string, prior []string, data *repositoryActionVariablesResourceModel) diag.Diagnostics {
+	var desired map[string]string
+
+	diags := data.Variables.ElementsAs(ctx, &desired, false)
+	if diags.HasError() {
+		return diags
+	}
+	names := slices.Sorted(maps.Keys(desired))
+
+	// Use Forgejo client to list current repository action variables
+	current, diags := listRepositoryActionVariables(ctx, r.client, owner, repo)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Create missing and update changed variables
+	for _, name := range names {
+		idx := slices.IndexFunc(current, func(v *forgejo.ActionVariable) bool {
+			return strings.EqualFold(v.Name, name)
+		})
+
+		switch {
+		case idx == -1:
+			diags.Append(r.createVariable(ctx, owner, repo, name, desired[name])...)
+		case current[idx].Data != desired[name]:
+			diags.Append(r.updateVariable(ctx, owner, repo, current[idx].Name, name, desired[name])...)
+		}
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// Delete removed and unmanaged variables
+	for _, variable := range current {
+		equalName := func(n string) bool {
+			return strings.EqualFold(n, variable.Name)
+		}
+		if slices.ContainsFunc(names, equalName) {
+			continue
+		}
+		if !data.DeleteUnmanaged.ValueBool() && !slices.ContainsFunc(prior, equalName) {
+			continue
+		}
+
+		diags.Append(r.deleteVariable(ctx, owner, repo, variable.Name)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// createVariable creates an action variable in the repository.
+func (r *repositoryActionVariablesResource) createVariable(ctx context.Context, owner, repo, name, data string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Create repository action variable", map[string]any{
+		"owner": owner,
+		"repo":  repo,
+		"name":  name,
+		"data":  data,
+	})
+
+	// Generate API request body
+	opts := forgejo.CreateVariableOption{
+		Name: name,
+		Data: data,
+	}
+
+	// Validate API request body
+	err := opts.Validate()
+	if err != nil {
+		diags.AddError("Input validation error", err.Error())
+
+		return diags
+	}
+
+	// Use Forgejo client to create new repository action variable
+	res, err := r.client.CreateRepoActionVariable(owner, repo, opts)
+	if err == nil {
+		return diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 400:
+			msg = fmt.Sprintf("Bad request: %s", err)
+		case 404:
+			msg = fmt.Sprintf(
+				"Repository with owner '%s' and name '%s' not found: %s",
+				owner,
+				repo,
+				err,
+			)
+		case 409:
+			msg = fmt.Sprintf(
+				"Action variable with owner '%s', repo '%s' and name '%s' conflict: %s",
+				owner,
+				repo,
+				name,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to create repository action variable", msg)
+
+	return diags
+}
+
+// updateVariable updates an action variable of the repository.
+func (r *repositoryActionVariablesResource) updateVariable(ctx context.Context, owner, repo, oldName, newName, data string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Update repository action variable", map[string]any{
+		"owner":    owner,
+		"repo":     repo,
+		"old_name": oldName,
+		"new_name": newName,
+		"data":     data,
+	})
+
+	// Generate API request body
+	opts := forgejo.CreateVariableOption{
+		Name: newName,
+		Data: data,
+	}
+
+	// Validate API request body
+	err := opts.Validate()
+	if err != nil {
+		diags.AddError("Input validation error", err.Error())
+
+		return diags
+	}
+
+	// Use Forgejo client to update repository action variable
+	res, err := r.client.UpdateRepoActionVariable(owner, repo, oldName, opts)
+	if err == nil {
+		return diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 400:
+			msg = fmt.Sprintf("Bad request: %s", err)
+		case 404:
+			msg = fmt.Sprintf(
+				"Action variable with owner '%s', repo '%s' and name '%s' not found: %s",
+				owner,
+				repo,
+				oldName,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to update repository action variable", msg)
+
+	return diags
+}
+
+// deleteVariable deletes an action variable of the repository.
+func (r *repositoryActionVariablesResource) deleteVariable(ctx context.Context, owner, repo, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Delete repository action variable", map[string]any{
+		"owner": owner,
+		"repo":  repo,
+		"name":  name,
+	})
+
+	// Use Forgejo client to delete existing repository action variable
+	res, err := r.client.DeleteRepoActionVariable(owner, repo, name)
+	if err == nil {
+		return diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 400:
+			msg = fmt.Sprintf("Bad request: %s", err)
+		case 404:
+			msg = fmt.Sprintf(
+				"Action variable with owner '%s', repo '%s' and name '%s' not found: %s",
+				owner,
+				repo,
+				name,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to delete repository action variable", msg)
+
+	return diags
+}
+
+// listRepositoryActionVariables is a helper function to fetch all action
+// variables of a repository.
+func listRepositoryActionVariables(ctx context.Context, client *forgejo.Client, owner, repo string) ([]*forgejo.ActionVariable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "List repository action variables", map[string]any{
+		"owner": owner,
+		"repo":  repo,
+	})
+
+	// Use Forgejo client to list repository action variables
+	variables, res, err := listAllPages(func(opts forgejo.ListOptions) ([]*forgejo.ActionVariable, *forgejo.Response, error) {
+		return client.ListRepoActionVariables(owner, repo, opts)
+	})
+	if err == nil {
+		return variables, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 404:
+			msg = fmt.Sprintf(
+				"Repository with owner '%s' and name '%s' not found: %s",
+				owner,
+				repo,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to list repository action variables", msg)
+
+	return nil, diags
+}
+
+// actionVariablesValue is a helper function to convert API variables into a
+// Terraform map value. The spelling of variable names already present in the
+// known map is preserved, as Forgejo compares variable names
+// case-insensitively. Variables not present in the known map are only
+// included if unmanaged is set.
+func actionVariablesValue(ctx context.Context, known types.Map, variables []*forgejo.ActionVariable, unmanaged bool) (types.Map, diag.Diagnostics) {
+	var prior map[string]string
+
+	diags := known.ElementsAs(ctx, &prior, false)
+	if diags.HasError() {
+		return known, diags
+	}
+	keys := slices.Collect(maps.Keys(prior))
+
+	values := make(map[string]string, len(variables))
+	for _, variable := range variables {
+		idx := slices.IndexFunc(keys, func(k string) bool {
+			return strings.EqualFold(k, variable.Name)
+		})
+
+		switch {
+		case idx != -1:
+			values[keys[idx]] = variable.Data
+		case unmanaged:
+			values[variable.Name] = variable.Data
+		}
+	}
+
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
@@ -0,0 +1,123 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccRepositoryActionVariablesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing (non-existent repository)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository_action_variables" "test" {
+	repository_id = -1
+	variables = {
+		my_variable = "my_variable_value"
+	}
+}`,
+				ExpectError: regexp.MustCompile("Repository with ID -1 not found"),
+			},
+			// Create and Read testing (empty value)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+resource "forgejo_repository_action_variables" "test" {
+	repository_id = forgejo_repository.test.id
+	variables = {
+		my_variable = ""
+	}
+}`,
+				ExpectError: regexp.MustCompile("string length must be at least 1"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+resource "forgejo_repository_action_variables" "test" {
+	repository_id = forgejo_repository.test.id
+	variables = {
+		my_variable       = "my_variable_value"
+		my_other_variable = "my_other_variable_value"
+	}
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository_action_variables.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository_action_variables.test", tfjsonpath.New("repository_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("forgejo_repository_action_variables.test", tfjsonpath.New("variables"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"my_variable":       knownvalue.StringExact("my_variable_value"),
+						"my_other_variable": knownvalue.StringExact("my_other_variable_value"),
+					})),
+					statecheck.ExpectKnownValue("forgejo_repository_action_variables.test", tfjsonpath.New("delete_unmanaged"), knownvalue.Bool(false)),
+				},
+			},
+			// Update and Read testing (add, change and remove variables)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+resource "forgejo_repository_action_variables" "test" {
+	repository_id = forgejo_repository.test.id
+	variables = {
+		my_other_variable = "my_new_variable_value"
+		my_new_variable   = "my_variable_value"
+	}
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository_action_variables.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository_action_variables.test", tfjsonpath.New("variables"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"my_other_variable": knownvalue.StringExact("my_new_variable_value"),
+						"my_new_variable":   knownvalue.StringExact("my_variable_value"),
+					})),
+				},
+			},
+			// Update and Read testing (delete unmanaged variables)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+resource "forgejo_repository_action_variables" "test" {
+	repository_id    = forgejo_repository.test.id
+	delete_unmanaged = true
+	variables = {
+		my_other_variable = "my_new_variable_value"
+		my_new_variable   = "my_variable_value"
+	}
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository_action_variables.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository_action_variables.test", tfjsonpath.New("variables"), knownvalue.MapSizeExact(2)),
+					statecheck.ExpectKnownValue("forgejo_repository_action_variables.test", tfjsonpath.New("delete_unmanaged"), knownvalue.Bool(true)),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
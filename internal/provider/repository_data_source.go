@@ -79,6 +79,200 @@ type repositoryDataSourceModel struct {
 	DefaultMergeStyle         types.String `tfsdk:"default_merge_style"`
 }
 
+func (m repositoryDataSourceModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                          types.Int64Type,
+		"owner":                       types.StringType,
+		"name":                        types.StringType,
+		"full_name":                   types.StringType,
+		"description":                 types.StringType,
+		"empty":                       types.BoolType,
+		"private":                     types.BoolType,
+		"fork":                        types.BoolType,
+		"template":                    types.BoolType,
+		"parent_id":                   types.Int64Type,
+		"mirror":                      types.BoolType,
+		"size":                        types.Int64Type,
+		"html_url":                    types.StringType,
+		"ssh_url":                     types.StringType,
+		"clone_url":                   types.StringType,
+		"clone_addr":                  types.StringType,
+		"website":                     types.StringType,
+		"stars_count":                 types.Int64Type,
+		"forks_count":                 types.Int64Type,
+		"watchers_count":              types.Int64Type,
+		"open_issues_count":           types.Int64Type,
+		"open_pr_counter":             types.Int64Type,
+		"release_counter":             types.Int64Type,
+		"default_branch":              types.StringType,
+		"archived":                    types.BoolType,
+		"created_at":                  types.StringType,
+		"updated_at":                  types.StringType,
+		"permissions":                 types.ObjectType{AttrTypes: repositoryDataSourcePermissions{}.attributeTypes()},
+		"has_issues":                  types.BoolType,
+		"internal_tracker":            types.ObjectType{AttrTypes: repositoryDataSourceInternalTracker{}.attributeTypes()},
+		"external_tracker":            types.ObjectType{AttrTypes: repositoryDataSourceExternalTracker{}.attributeTypes()},
+		"has_wiki":                    types.BoolType,
+		"external_wiki":               types.ObjectType{AttrTypes: repositoryDataSourceExternalWiki{}.attributeTypes()},
+		"has_pull_requests":           types.BoolType,
+		"has_projects":                types.BoolType,
+		"has_releases":                types.BoolType,
+		"has_packages":                types.BoolType,
+		"has_actions":                 types.BoolType,
+		"ignore_whitespace_conflicts": types.BoolType,
+		"allow_merge_commits":         types.BoolType,
+		"allow_rebase":                types.BoolType,
+		"allow_rebase_explicit":       types.BoolType,
+		"allow_squash_merge":          types.BoolType,
+		"avatar_url":                  types.StringType,
+		"internal":                    types.BoolType,
+		"mirror_interval":             types.StringType,
+		"mirror_updated":              types.StringType,
+		"default_merge_style":         types.StringType,
+	}
+}
+
+// from is a helper function to load an API struct into Terraform data model.
+func (m *repositoryDataSourceModel) from(ctx context.Context, rep *forgejo.Repository) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if rep == nil {
+		return diags
+	}
+
+	m.ID = types.Int64Value(rep.ID)
+
+	if rep.Owner != nil {
+		m.Owner = types.StringValue(rep.Owner.UserName)
+	}
+
+	m.Name = types.StringValue(rep.Name)
+	m.FullName = types.StringValue(rep.FullName)
+	m.Description = types.StringValue(rep.Description)
+	m.Empty = types.BoolValue(rep.Empty)
+	m.Private = types.BoolValue(rep.Private)
+	m.Fork = types.BoolValue(rep.Fork)
+	m.Template = types.BoolValue(rep.Template)
+
+	if rep.Parent != nil {
+		m.ParentID = types.Int64Value(rep.Parent.ID)
+	}
+
+	m.Mirror = types.BoolValue(rep.Mirror)
+	m.Size = types.Int64Value(int64(rep.Size))
+	m.HTMLURL = types.StringValue(rep.HTMLURL)
+	m.SSHURL = types.StringValue(rep.SSHURL)
+	m.CloneURL = types.StringValue(rep.CloneURL)
+	m.CloneAddr = types.StringValue(rep.OriginalURL)
+	m.Website = types.StringValue(rep.Website)
+	m.Stars = types.Int64Value(int64(rep.Stars))
+	m.Forks = types.Int64Value(int64(rep.Forks))
+	m.Watchers = types.Int64Value(int64(rep.Watchers))
+	m.OpenIssues = types.Int64Value(int64(rep.OpenIssues))
+	m.OpenPulls = types.Int64Value(int64(rep.OpenPulls))
+	m.Releases = types.Int64Value(int64(rep.Releases))
+	m.DefaultBranch = types.StringValue(rep.DefaultBranch)
+	m.Archived = types.BoolValue(rep.Archived)
+	m.Created = types.StringValue(rep.Created.Format(time.RFC3339))
+	m.Updated = types.StringValue(rep.Updated.Format(time.RFC3339))
+	m.HasIssues = types.BoolValue(rep.HasIssues)
+	m.HasWiki = types.BoolValue(rep.HasWiki)
+	m.HasPullRequests = types.BoolValue(rep.HasPullRequests)
+	m.HasProjects = types.BoolValue(rep.HasProjects)
+	m.HasReleases = types.BoolValue(rep.HasReleases)
+	m.HasPackages = types.BoolValue(rep.HasPackages)
+	m.HasActions = types.BoolValue(rep.HasActions)
+	m.IgnoreWhitespaceConflicts = types.BoolValue(rep.IgnoreWhitespaceConflicts)
+	m.AllowMerge = types.BoolValue(rep.AllowMerge)
+	m.AllowRebase = types.BoolValue(rep.AllowRebase)
+	m.AllowRebaseMerge = types.BoolValue(rep.AllowRebaseMerge)
+	m.AllowSquash = types.BoolValue(rep.AllowSquash)
+	m.AvatarURL = types.StringValue(rep.AvatarURL)
+	m.Internal = types.BoolValue(rep.Internal)
+	m.MirrorInterval = types.StringValue(rep.MirrorInterval)
+	m.MirrorUpdated = types.StringValue(rep.MirrorUpdated.Format(time.RFC3339))
+	m.DefaultMergeStyle = types.StringValue(string(rep.DefaultMergeStyle))
+
+	// Nested objects are null unless returned by the API
+	m.Permissions = types.ObjectNull(repositoryDataSourcePermissions{}.attributeTypes())
+	m.InternalTracker = types.ObjectNull(repositoryDataSourceInternalTracker{}.attributeTypes())
+	m.ExternalTracker = types.ObjectNull(repositoryDataSourceExternalTracker{}.attributeTypes())
+	m.ExternalWiki = types.ObjectNull(repositoryDataSourceExternalWiki{}.attributeTypes())
+
+	// Repository permissions
+	if rep.Permissions != nil {
+		perms := repositoryDataSourcePermissions{
+			Admin: types.BoolValue(rep.Permissions.Admin),
+			Push:  types.BoolValue(rep.Permissions.Push),
+			Pull:  types.BoolValue(rep.Permissions.Pull),
+		}
+		permsValue, diags := types.ObjectValueFrom(
+			ctx,
+			perms.attributeTypes(),
+			perms,
+		)
+		if diags.HasError() {
+			return diags
+		}
+		m.Permissions = permsValue
+	}
+
+	// Internal issue tracker
+	if rep.InternalTracker != nil {
+		intTracker := repositoryDataSourceInternalTracker{
+			EnableTimeTracker:                types.BoolValue(rep.InternalTracker.EnableTimeTracker),
+			AllowOnlyContributorsToTrackTime: types.BoolValue(rep.InternalTracker.AllowOnlyContributorsToTrackTime),
+			EnableIssueDependencies:          types.BoolValue(rep.InternalTracker.EnableIssueDependencies),
+		}
+		intTrackerValue, diags := types.ObjectValueFrom(
+			ctx,
+			intTracker.attributeTypes(),
+			intTracker,
+		)
+		if diags.HasError() {
+			return diags
+		}
+		m.InternalTracker = intTrackerValue
+	}
+
+	// External issue tracker
+	if rep.ExternalTracker != nil {
+		extTracker := repositoryDataSourceExternalTracker{
+			ExternalTrackerURL:          types.StringValue(rep.ExternalTracker.ExternalTrackerURL),
+			ExternalTrackerFormat:       types.StringValue(rep.ExternalTracker.ExternalTrackerFormat),
+			ExternalTrackerStyle:        types.StringValue(rep.ExternalTracker.ExternalTrackerStyle),
+			ExternalTrackerRegexPattern: types.StringValue(rep.ExternalTracker.ExternalTrackerRegexPattern),
+		}
+		extTrackerValue, diags := types.ObjectValueFrom(
+			ctx,
+			extTracker.attributeTypes(),
+			extTracker,
+		)
+		if diags.HasError() {
+			return diags
+		}
+		m.ExternalTracker = extTrackerValue
+	}
+
+	// External wiki
+	if rep.ExternalWiki != nil {
+		wiki := repositoryDataSourceExternalWiki{
+			ExternalWikiURL: types.StringValue(rep.ExternalWiki.ExternalWikiURL),
+		}
+		wikiValue, diags := types.ObjectValueFrom(
+			ctx,
+			wiki.attributeTypes(),
+			wiki,
+		)
+		if diags.HasError() {
+			return diags
+		}
+		m.ExternalWiki = wikiValue
+	}
+
+	return diags
+}
+
 // https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#Permission
 type repositoryDataSourcePermissions struct {
 	Admin types.Bool `tfsdk:"admin"`
@@ -144,254 +338,270 @@ func (d *repositoryDataSource) Metadata(_ context.Context, req datasource.Metada
 
 // Schema defines the schema for the data source.
 func (d *repositoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := repositoryDataSourceAttributes()
+	attributes["owner"] = schema.StringAttribute{
+		Description: "Owner of the repository (user or organization).",
+		Required:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "Name of the repository.",
+		Required:    true,
+	}
+
 	resp.Schema = schema.Schema{
 		Description: "Forgejo repository data source.",
 
-		Attributes: map[string]schema.Attribute{
-			"id": schema.Int64Attribute{
-				Description: "Numeric identifier of the repository.",
-				Computed:    true,
-			},
-			"owner": schema.StringAttribute{
-				Description: "Owner of the repository (user or organization).",
-				Required:    true,
-			},
-			"name": schema.StringAttribute{
-				Description: "Name of the repository.",
-				Required:    true,
-			},
-			"full_name": schema.StringAttribute{
-				Description: "Full name of the repository.",
-				Computed:    true,
-			},
-			"description": schema.StringAttribute{
-				Description: "Description of the repository.",
-				Computed:    true,
-			},
-			"empty": schema.BoolAttribute{
-				Description: "Is the repository empty?",
-				Computed:    true,
-			},
-			"private": schema.BoolAttribute{
-				Description: "Is the repository private?",
-				Computed:    true,
-			},
-			"fork": schema.BoolAttribute{
-				Description: "Is the repository a fork?",
-				Computed:    true,
-			},
-			"template": schema.BoolAttribute{
-				Description: "Is the repository a template?",
-				Computed:    true,
-			},
-			"parent_id": schema.Int64Attribute{
-				Description: "Numeric identifier of the parent repository.",
-				Computed:    true,
-			},
-			"mirror": schema.BoolAttribute{
-				Description: "Is the repository a mirror?",
-				Computed:    true,
-			},
-			"size": schema.Int64Attribute{
-				Description: "Size of the repository in KiB.",
-				Computed:    true,
-			},
-			"html_url": schema.StringAttribute{
-				Description: "HTML URL of the repository.",
-				Computed:    true,
-			},
-			"ssh_url": schema.StringAttribute{
-				Description: "SSH URL of the repository.",
-				Computed:    true,
-			},
-			"clone_url": schema.StringAttribute{
-				Description: "Clone URL of the repository.",
-				Computed:    true,
-			},
-			"clone_addr": schema.StringAttribute{
-				Description: "Migrate / clone from URL.",
-				Computed:    true,
-			},
-			"website": schema.StringAttribute{
-				Description: "Website of the repository.",
-				Computed:    true,
-			},
-			"stars_count": schema.Int64Attribute{
-				Description: "Number of stars of the repository.",
-				Computed:    true,
-			},
-			"forks_count": schema.Int64Attribute{
-				Description: "Number of forks of the repository.",
-				Computed:    true,
-			},
-			"watchers_count": schema.Int64Attribute{
-				Description: "Number of watchers of the repository.",
-				Computed:    true,
-			},
-			"open_issues_count": schema.Int64Attribute{
-				Description: "Number of open issues of the repository.",
-				Computed:    true,
-			},
-			"open_pr_counter": schema.Int64Attribute{
-				Description: "Number of open pull requests of the repository.",
-				Computed:    true,
-			},
-			"release_counter": schema.Int64Attribute{
-				Description: "Number of releases of the repository.",
-				Computed:    true,
-			},
-			"default_branch": schema.StringAttribute{
-				Description: "Default branch of the repository.",
-				Computed:    true,
-			},
-			"archived": schema.BoolAttribute{
-				Description: "Is the repository archived?",
-				Computed:    true,
-			},
-			"created_at": schema.StringAttribute{
-				Description: "Time at which the repository was created.",
-				Computed:    true,
-			},
-			"updated_at": schema.StringAttribute{
-				Description: "Time at which the repository was updated.",
-				Computed:    true,
-			},
-			"permissions": schema.SingleNestedAttribute{
-				Attributes: map[string]schema.Attribute{
-					"admin": schema.BoolAttribute{
-						Description: "Allowed to administer?",
-						Computed:    true,
-					},
-					"push": schema.BoolAttribute{
-						Description: "Allowed to push?",
-						Computed:    true,
-					},
-					"pull": schema.BoolAttribute{
-						Description: "Allowed to pull?",
-						Computed:    true,
-					},
+		Attributes: attributes,
+	}
+}
+
+// repositoryDataSourceAttributes returns the computed repository attributes
+// shared by the repository and repositories data sources.
+func repositoryDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Numeric identifier of the repository.",
+			Computed:    true,
+		},
+		"owner": schema.StringAttribute{
+			Description: "Owner of the repository (user or organization).",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the repository.",
+			Computed:    true,
+		},
+		"full_name": schema.StringAttribute{
+			Description: "Full name of the repository.",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Description of the repository.",
+			Computed:    true,
+		},
+		"empty": schema.BoolAttribute{
+			Description: "Is the repository empty?",
+			Computed:    true,
+		},
+		"private": schema.BoolAttribute{
+			Description: "Is the repository private?",
+			Computed:    true,
+		},
+		"fork": schema.BoolAttribute{
+			Description: "Is the repository a fork?",
+			Computed:    true,
+		},
+		"template": schema.BoolAttribute{
+			Description: "Is the repository a template?",
+			Computed:    true,
+		},
+		"parent_id": schema.Int64Attribute{
+			Description: "Numeric identifier of the parent repository.",
+			Computed:    true,
+		},
+		"mirror": schema.BoolAttribute{
+			Description: "Is the repository a mirror?",
+			Computed:    true,
+		},
+		"size": schema.Int64Attribute{
+			Description: "Size of the repository in KiB.",
+			Computed:    true,
+		},
+		"html_url": schema.StringAttribute{
+			Description: "HTML URL of the repository.",
+			Computed:    true,
+		},
+		"ssh_url": schema.StringAttribute{
+			Description: "SSH URL of the repository.",
+			Computed:    true,
+		},
+		"clone_url": schema.StringAttribute{
+			Description: "Clone URL of the repository.",
+			Computed:    true,
+		},
+		"clone_addr": schema.StringAttribute{
+			Description: "Migrate / clone from URL.",
+			Computed:    true,
+		},
+		"website": schema.StringAttribute{
+			Description: "Website of the repository.",
+			Computed:    true,
+		},
+		"stars_count": schema.Int64Attribute{
+			Description: "Number of stars of the repository.",
+			Computed:    true,
+		},
+		"forks_count": schema.Int64Attribute{
+			Description: "Number of forks of the repository.",
+			Computed:    true,
+		},
+		"watchers_count": schema.Int64Attribute{
+			Description: "Number of watchers of the repository.",
+			Computed:    true,
+		},
+		"open_issues_count": schema.Int64Attribute{
+			Description: "Number of open issues of the repository.",
+			Computed:    true,
+		},
+		"open_pr_counter": schema.Int64Attribute{
+			Description: "Number of open pull requests of the repository.",
+			Computed:    true,
+		},
+		"release_counter": schema.Int64Attribute{
+			Description: "Number of releases of the repository.",
+			Computed:    true,
+		},
+		"default_branch": schema.StringAttribute{
+			Description: "Default branch of the repository.",
+			Computed:    true,
+		},
+		"archived": schema.BoolAttribute{
+			Description: "Is the repository archived?",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "Time at which the repository was created.",
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "Time at which the repository was updated.",
+			Computed:    true,
+		},
+		"permissions": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"admin": schema.BoolAttribute{
+					Description: "Allowed to administer?",
+					Computed:    true,
 				},
-				Description: "Permissions of the repository.",
-				Computed:    true,
-			},
-			"has_issues": schema.BoolAttribute{
-				Description: "Is the repository issue tracker enabled?",
-				Computed:    true,
-			},
-			"internal_tracker": schema.SingleNestedAttribute{
-				Attributes: map[string]schema.Attribute{
-					"enable_time_tracker": schema.BoolAttribute{
-						Description: "Enable time tracking?",
-						Computed:    true,
-					},
-					"allow_only_contributors_to_track_time": schema.BoolAttribute{
-						Description: "Let only contributors track time?",
-						Computed:    true,
-					},
-					"enable_issue_dependencies": schema.BoolAttribute{
-						Description: "Enable dependencies for issues and pull requests?",
-						Computed:    true,
-					},
+				"push": schema.BoolAttribute{
+					Description: "Allowed to push?",
+					Computed:    true,
 				},
-				Description: "Settings for built-in issue tracker.",
-				Computed:    true,
-			},
-			"external_tracker": schema.SingleNestedAttribute{
-				Attributes: map[string]schema.Attribute{
-					"external_tracker_url": schema.StringAttribute{
-						Description: "URL of external issue tracker.",
-						Computed:    true,
-					},
-					"external_tracker_format": schema.StringAttribute{
-						Description: "External issue tracker URL format.",
-						Computed:    true,
-					},
-					"external_tracker_style": schema.StringAttribute{
-						Description: "External issue tracker number format.",
-						Computed:    true,
-					},
-					"external_tracker_regexp_pattern": schema.StringAttribute{
-						Description: "External issue tracker issue regular expression.",
-						Computed:    true,
-					},
+				"pull": schema.BoolAttribute{
+					Description: "Allowed to pull?",
+					Computed:    true,
 				},
-				Description: "Settings for external issue tracker.",
-				Computed:    true,
-			},
-			"has_wiki": schema.BoolAttribute{
-				Description: "Is the repository wiki enabled?",
-				Computed:    true,
 			},
-			"external_wiki": schema.SingleNestedAttribute{
-				Attributes: map[string]schema.Attribute{
-					"external_wiki_url": schema.StringAttribute{
-						Description: "URL of external wiki.",
-						Computed:    true,
-					},
+			Description: "Permissions of the repository.",
+			Computed:    true,
+		},
+		"has_issues": schema.BoolAttribute{
+			Description: "Is the repository issue tracker enabled?",
+			Computed:    true,
+		},
+		"internal_tracker": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"enable_time_tracker": schema.BoolAttribute{
+					Description: "Enable time tracking?",
+					Computed:    true,
+				},
+				"allow_only_contributors_to_track_time": schema.BoolAttribute{
+					Description: "Let only contributors track time?",
+					Computed:    true,
+				},
+				"enable_issue_dependencies": schema.BoolAttribute{
+					Description: "Enable dependencies for issues and pull requests?",
+					Computed:    true,
 				},
-				Description: "Settings for external wiki.",
-				Computed:    true,
-			},
-			"has_pull_requests": schema.BoolAttribute{
-				Description: "Are repository pull requests enabled?",
-				Computed:    true,
-			},
-			"has_projects": schema.BoolAttribute{
-				Description: "Are repository projects enabled?",
-				Computed:    true,
-			},
-			"has_releases": schema.BoolAttribute{
-				Description: "Are repository releases enabled?",
-				Computed:    true,
-			},
-			"has_packages": schema.BoolAttribute{
-				Description: "Is the repository package registry enabled?",
-				Computed:    true,
-			},
-			"has_actions": schema.BoolAttribute{
-				Description: "Are integrated CI/CD pipelines enabled?",
-				Computed:    true,
-			},
-			"ignore_whitespace_conflicts": schema.BoolAttribute{
-				Description: "Are whitespace conflicts ignored?",
-				Computed:    true,
-			},
-			"allow_merge_commits": schema.BoolAttribute{
-				Description: "Allowed to create merge commit?",
-				Computed:    true,
-			},
-			"allow_rebase": schema.BoolAttribute{
-				Description: "Allowed to rebase then fast-forward?",
-				Computed:    true,
-			},
-			"allow_rebase_explicit": schema.BoolAttribute{
-				Description: "Allowed to rebase then create merge commit?",
-				Computed:    true,
-			},
-			"allow_squash_merge": schema.BoolAttribute{
-				Description: "Allowed to create squash commit?",
-				Computed:    true,
-			},
-			"avatar_url": schema.StringAttribute{
-				Description: "Avatar URL of the repository.",
-				Computed:    true,
-			},
-			"internal": schema.BoolAttribute{
-				Description: "Is the repository internal?",
-				Computed:    true,
-			},
-			"mirror_interval": schema.StringAttribute{
-				Description: "Mirror interval of the repository.",
-				Computed:    true,
 			},
-			"mirror_updated": schema.StringAttribute{
-				Description: "Time at which the repository mirror was updated.",
-				Computed:    true,
+			Description: "Settings for built-in issue tracker.",
+			Computed:    true,
+		},
+		"external_tracker": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"external_tracker_url": schema.StringAttribute{
+					Description: "URL of external issue tracker.",
+					Computed:    true,
+				},
+				"external_tracker_format": schema.StringAttribute{
+					Description: "External issue tracker URL format.",
+					Computed:    true,
+				},
+				"external_tracker_style": schema.StringAttribute{
+					Description: "External issue tracker number format.",
+					Computed:    true,
+				},
+				"external_tracker_regexp_pattern": schema.StringAttribute{
+					Description: "External issue tracker issue regular expression.",
+					Computed:    true,
+				},
 			},
-			"default_merge_style": schema.StringAttribute{
-				Description: "Default merge style of the repository.",
-				Computed:    true,
+			Description: "Settings for external issue tracker.",
+			Computed:    true,
+		},
+		"has_wiki": schema.BoolAttribute{
+			Description: "Is the repository wiki enabled?",
+			Computed:    true,
+		},
+		"external_wiki": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"external_wiki_url": schema.StringAttribute{
+					Description: "URL of external wiki.",
+					Computed:    true,
+				},
 			},
+			Description: "Settings for external wiki.",
+			Computed:    true,
+		},
+		"has_pull_requests": schema.BoolAttribute{
+			Description: "Are repository pull requests enabled?",
+			Computed:    true,
+		},
+		"has_projects": schema.BoolAttribute{
+			Description: "Are repository projects enabled?",
+			Computed:    true,
+		},
+		"has_releases": schema.BoolAttribute{
+			Description: "Are repository releases enabled?",
+			Computed:    true,
+		},
+		"has_packages": schema.BoolAttribute{
+			Description: "Is the repository package registry enabled?",
+			Computed:    true,
+		},
+		"has_actions": schema.BoolAttribute{
+			Description: "Are integrated CI/CD pipelines enabled?",
+			Computed:    true,
+		},
+		"ignore_whitespace_conflicts": schema.BoolAttribute{
+			Description: "Are whitespace conflicts ignored?",
+			Computed:    true,
+		},
+		"allow_merge_commits": schema.BoolAttribute{
+			Description: "Allowed to create merge commit?",
+			Computed:    true,
+		},
+		"allow_rebase": schema.BoolAttribute{
+			Description: "Allowed to rebase then fast-forward?",
+			Computed:    true,
+		},
+		"allow_rebase_explicit": schema.BoolAttribute{
+			Description: "Allowed to rebase then create merge commit?",
+			Computed:    true,
+		},
+		"allow_squash_merge": schema.BoolAttribute{
+			Description: "Allowed to create squash commit?",
+			Computed:    true,
+		},
+		"avatar_url": schema.StringAttribute{
+			Description: "Avatar URL of the repository.",
+			Computed:    true,
+		},
+		"internal": schema.BoolAttribute{
+			Description: "Is the repository internal?",
+			Computed:    true,
+		},
+		"mirror_interval": schema.StringAttribute{
+			Description: "Mirror interval of the repository.",
+			Computed:    true,
+		},
+		"mirror_updated": schema.StringAttribute{
+			Description: "Time at which the repository mirror was updated.",
+			Computed:    true,
+		},
+		"default_merge_style": schema.StringAttribute{
+			Description: "Default merge style of the repository.",
+			Computed:    true,
 		},
 	}
 }
@@ -445,131 +655,10 @@ func (d *repositoryDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	// Map response body to model
-	data.ID = types.Int64Value(rep.ID)
-
-	if rep.Owner != nil {
-		data.Owner = types.StringValue(rep.Owner.UserName)
-	}
-
-	data.FullName = types.StringValue(rep.FullName)
-	data.Description = types.StringValue(rep.Description)
-	data.Empty = types.BoolValue(rep.Empty)
-	data.Private = types.BoolValue(rep.Private)
-	data.Fork = types.BoolValue(rep.Fork)
-	data.Template = types.BoolValue(rep.Template)
-
-	if rep.Parent != nil {
-		data.ParentID = types.Int64Value(rep.Parent.ID)
-	}
-
-	data.Mirror = types.BoolValue(rep.Mirror)
-	data.Size = types.Int64Value(int64(rep.Size))
-	data.HTMLURL = types.StringValue(rep.HTMLURL)
-	data.SSHURL = types.StringValue(rep.SSHURL)
-	data.CloneURL = types.StringValue(rep.CloneURL)
-	data.CloneAddr = types.StringValue(rep.OriginalURL)
-	data.Website = types.StringValue(rep.Website)
-	data.Stars = types.Int64Value(int64(rep.Stars))
-	data.Forks = types.Int64Value(int64(rep.Forks))
-	data.Watchers = types.Int64Value(int64(rep.Watchers))
-	data.OpenIssues = types.Int64Value(int64(rep.OpenIssues))
-	data.OpenPulls = types.Int64Value(int64(rep.OpenPulls))
-	data.Releases = types.Int64Value(int64(rep.Releases))
-	data.DefaultBranch = types.StringValue(rep.DefaultBranch)
-	data.Archived = types.BoolValue(rep.Archived)
-	data.Created = types.StringValue(rep.Created.Format(time.RFC3339))
-	data.Updated = types.StringValue(rep.Updated.Format(time.RFC3339))
-	data.HasIssues = types.BoolValue(rep.HasIssues)
-	data.HasWiki = types.BoolValue(rep.HasWiki)
-	data.HasPullRequests = types.BoolValue(rep.HasPullRequests)
-	data.HasProjects = types.BoolValue(rep.HasProjects)
-	data.HasReleases = types.BoolValue(rep.HasReleases)
-	data.HasPackages = types.BoolValue(rep.HasPackages)
-	data.HasActions = types.BoolValue(rep.HasActions)
-	data.IgnoreWhitespaceConflicts = types.BoolValue(rep.IgnoreWhitespaceConflicts)
-	data.AllowMerge = types.BoolValue(rep.AllowMerge)
-	data.AllowRebase = types.BoolValue(rep.AllowRebase)
-	data.AllowRebaseMerge = types.BoolValue(rep.AllowRebaseMerge)
-	data.AllowSquash = types.BoolValue(rep.AllowSquash)
-	data.AvatarURL = types.StringValue(rep.AvatarURL)
-	data.Internal = types.BoolValue(rep.Internal)
-	data.MirrorInterval = types.StringValue(rep.MirrorInterval)
-	data.MirrorUpdated = types.StringValue(rep.MirrorUpdated.Format(time.RFC3339))
-	data.DefaultMergeStyle = types.StringValue(string(rep.DefaultMergeStyle))
-
-	// Repository permissions
-	if rep.Permissions != nil {
-		perms := repositoryDataSourcePermissions{
-			Admin: types.BoolValue(rep.Permissions.Admin),
-			Push:  types.BoolValue(rep.Permissions.Push),
-			Pull:  types.BoolValue(rep.Permissions.Pull),
-		}
-		permsValue, diags := types.ObjectValueFrom(
-			ctx,
-			perms.attributeTypes(),
-			perms,
-		)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.Permissions = permsValue
-	}
-
-	// Internal issue tracker
-	if rep.InternalTracker != nil {
-		intTracker := repositoryDataSourceInternalTracker{
-			EnableTimeTracker:                types.BoolValue(rep.InternalTracker.EnableTimeTracker),
-			AllowOnlyContributorsToTrackTime: types.BoolValue(rep.InternalTracker.AllowOnlyContributorsToTrackTime),
-			EnableIssueDependencies:          types.BoolValue(rep.InternalTracker.EnableIssueDependencies),
-		}
-		intTrackerValue, diags := types.ObjectValueFrom(
-			ctx,
-			intTracker.attributeTypes(),
-			intTracker,
-		)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.InternalTracker = intTrackerValue
-	}
-
-	// External issue tracker
-	if rep.ExternalTracker != nil {
-		extTracker := repositoryDataSourceExternalTracker{
-			ExternalTrackerURL:          types.StringValue(rep.ExternalTracker.ExternalTrackerURL),
-			ExternalTrackerFormat:       types.StringValue(rep.ExternalTracker.ExternalTrackerFormat),
-			ExternalTrackerStyle:        types.StringValue(rep.ExternalTracker.ExternalTrackerStyle),
-			ExternalTrackerRegexPattern: types.StringValue(rep.ExternalTracker.ExternalTrackerRegexPattern),
-		}
-		extTrackerValue, diags := types.ObjectValueFrom(
-			ctx,
-			extTracker.attributeTypes(),
-			extTracker,
-		)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.ExternalTracker = extTrackerValue
-	}
-
-	// External wiki
-	if rep.ExternalWiki != nil {
-		wiki := repositoryDataSourceExternalWiki{
-			ExternalWikiURL: types.StringValue(rep.ExternalWiki.ExternalWikiURL),
-		}
-		wikiValue, diags := types.ObjectValueFrom(
-			ctx,
-			wiki.attributeTypes(),
-			wiki,
-		)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		data.ExternalWiki = wikiValue
+	diags = data.from(ctx, rep)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Save data into Terraform state
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+const (
+	// repositoryMirrorSyncDefaultTimeout is the default number of seconds
+	// to wait for a mirror synchronization to complete.
+	repositoryMirrorSyncDefaultTimeout = 300
+
+	// repositoryMirrorSyncPollInterval is the interval between checks of
+	// the mirror synchronization state.
+	repositoryMirrorSyncPollInterval = 5 * time.Second
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &repositoryMirrorSyncAction{}
+	_ action.ActionWithConfigure = &repositoryMirrorSyncAction{}
+)
+
+// repositoryMirrorSyncAction is the action implementation.
+type repositoryMirrorSyncAction struct {
+	client *forgejo.Client
+}
+
+// repositoryMirrorSyncActionModel maps the action schema data.
+type repositoryMirrorSyncActionModel struct {
+	Owner       types.String `tfsdk:"owner"`
+	Name        types.String `tfsdk:"name"`
+	Wait        types.Bool   `tfsdk:"wait"`
+	WaitTimeout types.Int64  `tfsdk:"wait_timeout"`
+}
+
+// Metadata returns the action type name.
+func (a *repositoryMirrorSyncAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_mirror_sync"
+}
+
+// Schema defines the schema for the action.
+func (a *repositoryMirrorSyncAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forgejo repository mirror synchronization action.",
+		MarkdownDescription: `Forgejo repository mirror synchronization action.
+
+**Note**: Actions require Terraform 1.14 or later!`,
+
+		Attributes: map[string]schema.Attribute{
+			"owner": schema.StringAttribute{
+				Description: "Owner of the mirror repository.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the mirror repository.",
+				Required:    true,
+			},
+			"wait": schema.BoolAttribute{
+				Description: "Wait until the mirror has been synchronized? Defaults to 'false'.",
+				Optional:    true,
+			},
+			"wait_timeout": schema.Int64Attribute{
+				Description: fmt.Sprintf(
+					"Number of seconds to wait for the mirror synchronization to complete. Defaults to '%d'.",
+					repositoryMirrorSyncDefaultTimeout,
+				),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *repositoryMirrorSyncAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+// Invoke triggers the mirror synchronization.
+func (a *repositoryMirrorSyncAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer un(trace(ctx, "Invoke repository mirror sync action"))
+
+	var data repositoryMirrorSyncActionModel
+
+	// Read Terraform configuration data into model
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository
+	rep, diags := getRepositoryByName(ctx, a.client, data.Owner.ValueString(), data.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !rep.Mirror {
+		resp.Diagnostics.AddError(
+			"Unable to synchronize mirror",
+			fmt.Sprintf(
+				"Repository with owner '%s' and name '%s' is not a mirror.",
+				data.Owner.ValueString(),
+				data.Name.ValueString(),
+			),
+		)
+
+		return
+	}
+	lastUpdated := rep.MirrorUpdated
+
+	tflog.Info(ctx, "Synchronize mirror", map[string]any{
+		"owner":          data.Owner.ValueString(),
+		"name":           data.Name.ValueString(),
+		"mirror_updated": lastUpdated.Format(time.RFC3339),
+	})
+
+	// Use Forgejo client to trigger mirror synchronization
+	res, err := a.client.MirrorSync(data.Owner.ValueString(), data.Name.ValueString())
+	if err != nil {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			tflog.Error(ctx, "Error", map[string]any{
+				"status": res.Status,
+			})
+
+			switch res.StatusCode {
+			case 403:
+				msg = fmt.Sprintf(
+					"Mirror synchronization of repository with owner '%s' and name '%s' forbidden: %s",
+					data.Owner.ValueString(),
+					data.Name.ValueString(),
+					err,
+				)
+			case 404:
+				msg = fmt.Sprintf(
+					"Repository with owner '%s' and name '%s' not found: %s",
+					data.Owner.ValueString(),
+					data.Name.ValueString(),
+					err,
+				)
+			default:
+				msg = fmt.Sprintf(
+					"Unknown error (status %d): %s",
+					res.StatusCode,
+					err,
+				)
+			}
+		}
+		resp.Diagnostics.AddError("Unable to synchronize mirror", msg)
+
+		return
+	}
+
+	if !data.Wait.ValueBool() {
+		return
+	}
+
+	timeout := int64(repositoryMirrorSyncDefaultTimeout)
+	if !data.WaitTimeout.IsNull() {
+		timeout = data.WaitTimeout.ValueInt64()
+	}
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+
+	// Poll repository until mirror has been updated
+	for {
+		rep, diags = getRepositoryByName(ctx, a.client, data.Owner.ValueString(), data.Name.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if rep.MirrorUpdated.After(lastUpdated) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf(
+					"Mirror synchronized at %s",
+					rep.MirrorUpdated.Format(time.RFC3339),
+				),
+			})
+
+			return
+		}
+
+		if time.Now().After(deadline) {
+			resp.Diagnostics.AddError(
+				"Unable to synchronize mirror",
+				fmt.Sprintf(
+					"Mirror synchronization of repository with owner '%s' and name '%s' did not complete within %d seconds.",
+					data.Owner.ValueString(),
+					data.Name.ValueString(),
+					timeout,
+				),
+			)
+
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: "Waiting for mirror synchronization to complete...",
+		})
+
+		select {
+		case <-ctx.Done():
+			resp.Diagnostics.AddError("Unable to synchronize mirror", ctx.Err().Error())
+
+			return
+		case <-time.After(repositoryMirrorSyncPollInterval):
+		}
+	}
+}
+
+// NewRepositoryMirrorSyncAction is a helper function to simplify the provider implementation.
+func NewRepositoryMirrorSyncAction() action.Action {
+	return &repositoryMirrorSyncAction{}
+}
@@ -0,0 +1,88 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccRepositoryMirrorSyncAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			// Invoke testing (non-existent repository)
+			{
+				Config: providerConfig + `
+action "forgejo_repository_mirror_sync" "test" {
+	config {
+		owner = "` + forgejoTestUser + `"
+		name  = "non_existent"
+	}
+}
+resource "terraform_data" "test" {
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.forgejo_repository_mirror_sync.test]
+		}
+	}
+}`,
+				ExpectError: regexp.MustCompile("Repository with owner '" + forgejoTestUser + "' and name 'non_existent' not found"),
+			},
+			// Invoke testing (non-mirror repository)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "tftest"
+}
+action "forgejo_repository_mirror_sync" "test" {
+	config {
+		owner = forgejo_repository.test.owner
+		name  = forgejo_repository.test.name
+	}
+}
+resource "terraform_data" "test" {
+	input = forgejo_repository.test.id
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.forgejo_repository_mirror_sync.test]
+		}
+	}
+}`,
+				ExpectError: regexp.MustCompile("is not a mirror"),
+			},
+			// Invoke testing (mirror repository)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "mirror" {
+	name       = "tftest_mirror"
+	clone_addr = "https://github.com/svalabs/terraform-provider-forgejo"
+	mirror     = true
+}
+action "forgejo_repository_mirror_sync" "test" {
+	config {
+		owner = forgejo_repository.mirror.owner
+		name  = forgejo_repository.mirror.name
+		wait  = true
+	}
+}
+resource "terraform_data" "test" {
+	input = forgejo_repository.mirror.id
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.forgejo_repository_mirror_sync.test]
+		}
+	}
+}`,
+			},
+		},
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -33,6 +34,16 @@ import (
 	forgejoStringValidator "terraform-provider-forgejo/internal/stringvalidator"
 )
 
+const (
+	// repositoryForkTimeout is the maximum time to wait for a repository
+	// fork to become available.
+	repositoryForkTimeout = 5 * time.Minute
+
+	// repositoryForkPollInterval is the interval between checks of the
+	// repository fork state.
+	repositoryForkPollInterval = 2 * time.Second
+)
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &repositoryResource{}
@@ -118,7 +129,11 @@ type repositoryResourceModel struct {
 	Milestones                    types.Bool   `tfsdk:"milestones"`
 	Labels                        types.Bool   `tfsdk:"labels"`
 	Service                       types.String `tfsdk:"service"`
+	ForkFrom                      types.Object `tfsdk:"fork_from"`
+	FromTemplate                  types.Object `tfsdk:"from_template"`
+	TeamIDs                       types.Set    `tfsdk:"team_ids"`
 	ArchiveOnDestroy              types.Bool   `tfsdk:"archive_on_destroy"`
+	PreventDestroyIfNotEmpty      types.Bool   `tfsdk:"prevent_destroy_if_not_empty"`
 }
 
 // from is a helper function to load an API struct into Terraform data model.
@@ -196,7 +211,8 @@ func (m *repositoryResourceModel) from(r *forgejo.Repository) {
 	// Labels, Service, AllowManualMerge, AutodetectManualMerge,
 	// DefaultDeleteBranchAfterMerge, AllowFastForwardOnly, AllowRebaseUpdate,
 	// DefaultAllowMaintainerEdit, DefaultUpdateStyle, EnablePrune,
-	// GloballyEditableWiki, WikiBranch, ArchiveOnDestroy
+	// GloballyEditableWiki, WikiBranch, ForkFrom, FromTemplate, TeamIDs,
+	// ArchiveOnDestroy, PreventDestroyIfNotEmpty
 }
 
 // to is a helper function to save Terraform data model into an API struct.
@@ -479,6 +495,61 @@ func (m *repositoryResourceModel) externalWikiTo(ctx context.Context, o *forgejo
 	return diags
 }
 
+// repositoryResourceForkFrom maps the fork source schema data.
+type repositoryResourceForkFrom struct {
+	Owner      types.String `tfsdk:"owner"`
+	Repository types.String `tfsdk:"repository"`
+}
+
+func (m repositoryResourceForkFrom) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"owner":      types.StringType,
+		"repository": types.StringType,
+	}
+}
+
+// repositoryResourceFromTemplate maps the template source schema data.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#CreateRepoFromTemplateOption
+type repositoryResourceFromTemplate struct {
+	Owner             types.String `tfsdk:"owner"`
+	Repository        types.String `tfsdk:"repository"`
+	GitContent        types.Bool   `tfsdk:"git_content"`
+	Topics            types.Bool   `tfsdk:"topics"`
+	Labels            types.Bool   `tfsdk:"labels"`
+	Webhooks          types.Bool   `tfsdk:"webhooks"`
+	Avatar            types.Bool   `tfsdk:"avatar"`
+	GitHooks          types.Bool   `tfsdk:"git_hooks"`
+	ProtectedBranches types.Bool   `tfsdk:"protected_branches"`
+}
+
+func (m repositoryResourceFromTemplate) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"owner":              types.StringType,
+		"repository":         types.StringType,
+		"git_content":        types.BoolType,
+		"topics":             types.BoolType,
+		"labels":             types.BoolType,
+		"webhooks":           types.BoolType,
+		"avatar":             types.BoolType,
+		"git_hooks":          types.BoolType,
+		"protected_branches": types.BoolType,
+	}
+}
+
+// to is a helper function to save Terraform data model into an API struct.
+func (m *repositoryResourceFromTemplate) to(o *forgejo.CreateRepoFromTemplateOption) {
+	if o == nil {
+		return
+	}
+
+	o.GitContent = m.GitContent.ValueBool()
+	o.Topics = m.Topics.ValueBool()
+	o.Labels = m.Labels.ValueBool()
+	o.Webhooks = m.Webhooks.ValueBool()
+	o.Avatar = m.Avatar.ValueBool()
+	o.GitHooks = m.GitHooks.ValueBool()
+}
+
 // Metadata returns the resource type name.
 func (r *repositoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_repository"
@@ -500,11 +571,10 @@ func (r *repositoryResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"owner": schema.StringAttribute{
-				Description: "Owner of the repository (user or organization). Changing this forces a new resource to be created.",
+				Description: "Owner of the repository (user or organization). Changing this transfers the repository to the new owner. **Note**: Unless the authenticated user has administrative privileges, transfers to other users remain pending until accepted by the new owner. While a transfer is pending, the state keeps the current owner and the apply fails, so the owner change is planned again until it has been accepted.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplaceIfConfigured(),
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
@@ -1203,6 +1273,101 @@ func (r *repositoryResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					),
 				},
 			},
+			"fork_from": schema.SingleNestedAttribute{
+				// Create-only attribute
+				Attributes: map[string]schema.Attribute{
+					"owner": schema.StringAttribute{
+						Description: "Owner of the repository to fork.",
+						Required:    true,
+					},
+					"repository": schema.StringAttribute{
+						Description: "Name of the repository to fork.",
+						Required:    true,
+					},
+				},
+				MarkdownDescription: "Fork from an existing repository. Changing this forces a new resource to be created. **Note**: The name and target namespace of the fork are not set in this block: the fork is named after the top-level `name` attribute and created in the organization given by the top-level `owner` attribute. Leave `owner` unset (or set it to the authenticated user) to create a personal fork.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("clone_addr"),
+					}...),
+				},
+			},
+			"from_template": schema.SingleNestedAttribute{
+				// Create-only attribute
+				Attributes: map[string]schema.Attribute{
+					"owner": schema.StringAttribute{
+						Description: "Owner of the template repository.",
+						Required:    true,
+					},
+					"repository": schema.StringAttribute{
+						Description: "Name of the template repository.",
+						Required:    true,
+					},
+					"git_content": schema.BoolAttribute{
+						Description: "Include git content of the default branch of the template repository?",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+					"topics": schema.BoolAttribute{
+						Description: "Include topics of the template repository?",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+					"labels": schema.BoolAttribute{
+						Description: "Include labels of the template repository?",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+					"webhooks": schema.BoolAttribute{
+						Description: "Include webhooks of the template repository?",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+					"avatar": schema.BoolAttribute{
+						Description: "Include avatar of the template repository?",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+					"git_hooks": schema.BoolAttribute{
+						Description: "Include git hooks of the template repository? **Note**: This requires the authenticated user to be allowed to edit git hooks.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+					"protected_branches": schema.BoolAttribute{
+						Description: "Include branch protection rules of the template repository?",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+				Description: "Generate from an existing template repository. Changing this forces a new resource to be created.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("clone_addr"),
+						path.MatchRoot("fork_from"),
+					}...),
+				},
+			},
+			"team_ids": schema.SetAttribute{
+				// Write-only attribute
+				Description: "Numeric identifiers of the teams to add to the repository when transferring it to an organization. **Note**: This setting is only effective if `owner` changes.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
 			"archive_on_destroy": schema.BoolAttribute{
 				// Provider behavior flag
 				Description: "Archive the repo instead of delete?",
@@ -1210,6 +1375,13 @@ func (r *repositoryResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"prevent_destroy_if_not_empty": schema.BoolAttribute{
+				// Provider behavior flag
+				Description: "Refuse to delete the repo if it contains commits, open issues or releases?",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -1309,6 +1481,119 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 
 		// Use Forgejo client to create new repository migration
 		rep, res, err = r.client.MigrateRepo(copts)
+	} else if !data.ForkFrom.IsNull() {
+		var forkFrom repositoryResourceForkFrom
+		diags = data.ForkFrom.As(ctx, &forkFrom, basetypes.ObjectAsOptions{})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Info(ctx, "Fork repository", map[string]any{
+			"fork_owner":      forkFrom.Owner.ValueString(),
+			"fork_repository": forkFrom.Repository.ValueString(),
+			"owner":           data.Owner.ValueString(),
+			"name":            data.Name.ValueString(),
+		})
+
+		// Generate API request body from plan
+		copts := forgejo.CreateForkOption{
+			Name: data.Name.ValueStringPointer(),
+		}
+
+		// Determine target namespace of fork
+		if data.Owner.ValueString() != "" {
+			diags = r.forkNamespaceTo(data.Owner.ValueString(), &copts)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		// Use Forgejo client to create new repository fork
+		rep, res, err = r.client.CreateFork(
+			forkFrom.Owner.ValueString(),
+			forkFrom.Repository.ValueString(),
+			copts,
+		)
+		if err == nil {
+			// Wait for repository fork to become available
+			rep, diags = r.waitForFork(ctx, rep.ID)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	} else if !data.FromTemplate.IsNull() {
+		var fromTemplate repositoryResourceFromTemplate
+		diags = data.FromTemplate.As(ctx, &fromTemplate, basetypes.ObjectAsOptions{})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Determine owner of repository
+		owner := data.Owner.ValueString()
+		if owner == "" {
+			// No owner -> personal repository
+			usr, res, err := r.client.GetMyUserInfo()
+			if err != nil {
+				var msg string
+				if res == nil {
+					msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+				} else {
+					msg = fmt.Sprintf(
+						"Unknown error (status %d): %s",
+						res.StatusCode,
+						err,
+					)
+				}
+				resp.Diagnostics.AddError("Unable to get authenticated user", msg)
+
+				return
+			}
+			owner = usr.UserName
+		}
+
+		tflog.Info(ctx, "Generate repository", map[string]any{
+			"template_owner":      fromTemplate.Owner.ValueString(),
+			"template_repository": fromTemplate.Repository.ValueString(),
+			"owner":               owner,
+			"name":                data.Name.ValueString(),
+			"description":         data.Description.ValueString(),
+			"private":             data.Private.ValueBool(),
+			"git_content":         fromTemplate.GitContent.ValueBool(),
+			"topics":              fromTemplate.Topics.ValueBool(),
+			"labels":              fromTemplate.Labels.ValueBool(),
+			"webhooks":            fromTemplate.Webhooks.ValueBool(),
+			"avatar":              fromTemplate.Avatar.ValueBool(),
+			"git_hooks":           fromTemplate.GitHooks.ValueBool(),
+			"protected_branches":  fromTemplate.ProtectedBranches.ValueBool(),
+		})
+
+		// Generate API request body from plan
+		copts := forgejo.CreateRepoFromTemplateOption{
+			Owner:       owner,
+			Name:        data.Name.ValueString(),
+			Description: data.Description.ValueString(),
+			Private:     data.Private.ValueBool(),
+		}
+		fromTemplate.to(&copts)
+
+		// Validate API request body
+		err = copts.Validate()
+		if err != nil {
+			resp.Diagnostics.AddError("Input validation error", err.Error())
+
+			return
+		}
+
+		// Use Forgejo client to generate new repository from template
+		rep, res, err = r.client.CreateRepoFromTemplate(
+			fromTemplate.Owner.ValueString(),
+			fromTemplate.Repository.ValueString(),
+			copts,
+		)
 	} else {
 		tflog.Info(ctx, "Create repository", map[string]any{
 			"owner":          data.Owner.ValueString(),
@@ -1475,6 +1760,30 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// Copy branch protections from template repository
+	if !data.FromTemplate.IsNull() {
+		var fromTemplate repositoryResourceFromTemplate
+		diags = data.FromTemplate.As(ctx, &fromTemplate, basetypes.ObjectAsOptions{})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if fromTemplate.ProtectedBranches.ValueBool() {
+			diags = r.copyBranchProtections(
+				ctx,
+				fromTemplate.Owner.ValueString(),
+				fromTemplate.Repository.ValueString(),
+				rep.Owner.UserName,
+				data.Name.ValueString(),
+			)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
 	// Create-then-edit: Create*Repo() / MigrateRepo() support only a subset of fields
 	tflog.Info(ctx, "Update repository", map[string]any{
 		"owner":                             rep.Owner.UserName,
@@ -1663,6 +1972,27 @@ func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequ
 		owner = state.Owner.ValueString()
 	}
 
+	// Transfer repository to new owner
+	pending := false
+	if !strings.EqualFold(owner, state.Owner.ValueString()) {
+		pending, diags = r.transfer(
+			ctx,
+			state.Owner.ValueString(),
+			state.Name.ValueString(),
+			owner,
+			data.TeamIDs,
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if pending {
+			// Repository remains with previous owner until accepted
+			owner = state.Owner.ValueString()
+		}
+	}
+
 	tflog.Info(ctx, "Update repository", map[string]any{
 		"owner":                             owner,
 		"name":                              data.Name.ValueString(),
@@ -1779,6 +2109,22 @@ func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Save data into Terraform state
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+
+	if pending {
+		// State keeps the current owner, so the transfer remains planned
+		// until it has been accepted. Terraform requires an error when the
+		// applied owner differs from the planned one.
+		resp.Diagnostics.AddError(
+			"Repository owner not changed",
+			fmt.Sprintf(
+				"Repository '%s/%s' remains with its current owner until the transfer to '%s' is accepted. "+
+					"The owner change stays in the plan until then.",
+				state.Owner.ValueString(),
+				state.Name.ValueString(),
+				data.Owner.ValueString(),
+			),
+		)
+	}
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -1817,6 +2163,45 @@ func (r *repositoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 			opts,
 		)
 	} else {
+		if data.PreventDestroyIfNotEmpty.ValueBool() {
+			// Use Forgejo client to get repository
+			rep, diags := getRepositoryByID(
+				ctx,
+				r.client,
+				data.ID.ValueInt64(),
+			)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			// Refuse to delete non-empty repository
+			var reasons []string
+			if !rep.Empty || rep.Size > 0 {
+				reasons = append(reasons, fmt.Sprintf("it has commits (size %d KiB)", rep.Size))
+			}
+			if rep.OpenIssues > 0 {
+				reasons = append(reasons, fmt.Sprintf("it has %d open issue(s)", rep.OpenIssues))
+			}
+			if rep.Releases > 0 {
+				reasons = append(reasons, fmt.Sprintf("it has %d release(s)", rep.Releases))
+			}
+			if len(reasons) > 0 {
+				resp.Diagnostics.AddError(
+					"Unable to delete repository",
+					fmt.Sprintf(
+						"Repository with owner %s and name %s is not empty: %s. "+
+							"Set prevent_destroy_if_not_empty to false or archive_on_destroy to true to destroy it.",
+						data.Owner.String(),
+						data.Name.String(),
+						strings.Join(reasons, ", "),
+					),
+				)
+
+				return
+			}
+		}
+
 		tflog.Info(ctx, "Delete repository", map[string]any{
 			"owner": data.Owner.ValueString(),
 			"name":  data.Name.ValueString(),
@@ -1916,6 +2301,7 @@ func (r *repositoryResource) ImportState(ctx context.Context, req resource.Impor
 	// Initialize write-only fields to their default values
 	state.AllowManualMerge = types.BoolValue(false)
 	state.ArchiveOnDestroy = types.BoolValue(false)
+	state.PreventDestroyIfNotEmpty = types.BoolValue(false)
 	state.AutoInit = types.BoolValue(true)
 	state.AutodetectManualMerge = types.BoolValue(false)
 	state.DefaultDeleteBranchAfterMerge = types.BoolValue(false)
@@ -1940,6 +2326,15 @@ func (r *repositoryResource) ImportState(ctx context.Context, req resource.Impor
 	// Initialize sensitive write-only fields to null value
 	state.AuthToken = types.StringNull()
 
+	// Initialize write-only and create-only fields to null value
+	state.TeamIDs = types.SetNull(types.Int64Type)
+	state.ForkFrom = types.ObjectNull(
+		repositoryResourceForkFrom{}.attributeTypes(),
+	)
+	state.FromTemplate = types.ObjectNull(
+		repositoryResourceFromTemplate{}.attributeTypes(),
+	)
+
 	// Save data into Terraform state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -1949,3 +2344,330 @@ func (r *repositoryResource) ImportState(ctx context.Context, req resource.Impor
 func NewRepositoryResource() resource.Resource {
 	return &repositoryResource{}
 }
+
+// forkNamespaceTo is a helper function to set the target namespace of a
+// repository fork. Forks can be created in organizations or in the namespace
+// of the authenticated user.
+func (r *repositoryResource) forkNamespaceTo(owner string, o *forgejo.CreateForkOption) (diags diag.Diagnostics) {
+	// Use Forgejo client to check if owner is org
+	_, res, err := r.client.GetOrg(owner)
+	if err == nil {
+		// Org exists -> fork into organization
+		o.Organization = &owner
+
+		return diags
+	}
+	if res == nil || res.StatusCode != 404 {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+		diags.AddError("Unable to check if owner is organization", msg)
+
+		return diags
+	}
+
+	// Use Forgejo client to get authenticated user
+	usr, res, err := r.client.GetMyUserInfo()
+	if err != nil {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+		diags.AddError("Unable to get authenticated user", msg)
+
+		return diags
+	}
+	if !strings.EqualFold(usr.UserName, owner) {
+		diags.AddError(
+			"Unable to fork repository",
+			fmt.Sprintf(
+				"Repositories can only be forked into an organization or the namespace of the authenticated user '%s', got owner '%s'",
+				usr.UserName,
+				owner,
+			),
+		)
+	}
+
+	return diags
+}
+
+// waitForFork is a helper function to wait for a repository fork to
+// become available.
+func (r *repositoryResource) waitForFork(ctx context.Context, id int64) (*forgejo.Repository, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	deadline := time.Now().Add(repositoryForkTimeout)
+
+	for {
+		// Use Forgejo client to get repository
+		rep, res, err := r.client.GetRepoByID(id)
+		if err == nil && (rep.Parent == nil || !rep.Empty || rep.Parent.Empty) {
+			return rep, diags
+		}
+		if err != nil && (res == nil || res.StatusCode != 404) {
+			var msg string
+			if res == nil {
+				msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+			} else {
+				tflog.Error(ctx, "Error", map[string]any{
+					"status": res.Status,
+				})
+
+				msg = fmt.Sprintf(
+					"Unknown error (status %d): %s",
+					res.StatusCode,
+					err,
+				)
+			}
+			diags.AddError("Unable to read repository", msg)
+
+			return nil, diags
+		}
+
+		if time.Now().After(deadline) {
+			diags.AddError(
+				"Unable to fork repository",
+				fmt.Sprintf(
+					"Repository fork with ID %d did not become available within %s",
+					id,
+					repositoryForkTimeout,
+				),
+			)
+
+			return nil, diags
+		}
+
+		tflog.Info(ctx, "Wait for repository fork", map[string]any{
+			"id": id,
+		})
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("Unable to fork repository", ctx.Err().Error())
+
+			return nil, diags
+		case <-time.After(repositoryForkPollInterval):
+		}
+	}
+}
+
+// copyBranchProtections is a helper function to copy the branch protection
+// rules of one repository to another repository.
+func (r *repositoryResource) copyBranchProtections(ctx context.Context, fromOwner, fromRepo, toOwner, toRepo string) (diags diag.Diagnostics) {
+	tflog.Info(ctx, "Copy branch protections", map[string]any{
+		"from_owner": fromOwner,
+		"from_repo":  fromRepo,
+		"to_owner":   toOwner,
+		"to_repo":    toRepo,
+	})
+
+	// Use Forgejo client to list branch protections
+	bps, res, err := r.client.ListBranchProtections(
+		fromOwner,
+		fromRepo,
+		forgejo.ListBranchProtectionsOptions{
+			ListOptions: forgejo.ListOptions{Page: -1},
+		},
+	)
+	if err != nil {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			tflog.Error(ctx, "Error", map[string]any{
+				"status": res.Status,
+			})
+
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+		diags.AddError("Unable to list branch protections", msg)
+
+		return diags
+	}
+
+	for _, bp := range bps {
+		opts := forgejo.CreateBranchProtectionOption{
+			BranchName:                    bp.BranchName,
+			RuleName:                      bp.RuleName,
+			EnablePush:                    bp.EnablePush,
+			EnablePushWhitelist:           bp.EnablePushWhitelist,
+			PushWhitelistUsernames:        bp.PushWhitelistUsernames,
+			PushWhitelistTeams:            bp.PushWhitelistTeams,
+			PushWhitelistDeployKeys:       bp.PushWhitelistDeployKeys,
+			EnableMergeWhitelist:          bp.EnableMergeWhitelist,
+			MergeWhitelistUsernames:       bp.MergeWhitelistUsernames,
+			MergeWhitelistTeams:           bp.MergeWhitelistTeams,
+			EnableStatusCheck:             bp.EnableStatusCheck,
+			StatusCheckContexts:           bp.StatusCheckContexts,
+			RequiredApprovals:             bp.RequiredApprovals,
+			EnableApprovalsWhitelist:      bp.EnableApprovalsWhitelist,
+			ApprovalsWhitelistUsernames:   bp.ApprovalsWhitelistUsernames,
+			ApprovalsWhitelistTeams:       bp.ApprovalsWhitelistTeams,
+			BlockOnRejectedReviews:        bp.BlockOnRejectedReviews,
+			BlockOnOfficialReviewRequests: bp.BlockOnOfficialReviewRequests,
+			BlockOnOutdatedBranch:         bp.BlockOnOutdatedBranch,
+			DismissStaleApprovals:         bp.DismissStaleApprovals,
+			RequireSignedCommits:          bp.RequireSignedCommits,
+			ProtectedFilePatterns:         bp.ProtectedFilePatterns,
+			UnprotectedFilePatterns:       bp.UnprotectedFilePatterns,
+		}
+
+		// Use Forgejo client to create branch protection
+		_, res, err = r.client.CreateBranchProtection(toOwner, toRepo, opts)
+		if err != nil {
+			var msg string
+			if res == nil {
+				msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+			} else {
+				tflog.Error(ctx, "Error", map[string]any{
+					"status": res.Status,
+				})
+
+				switch res.StatusCode {
+				case 403:
+					msg = fmt.Sprintf(
+						"Branch protection '%s' forbidden: %s",
+						bp.RuleName,
+						err,
+					)
+				case 422:
+					msg = fmt.Sprintf("Input validation error: %s", err)
+				default:
+					msg = fmt.Sprintf(
+						"Unknown error (status %d): %s",
+						res.StatusCode,
+						err,
+					)
+				}
+			}
+			diags.AddError("Unable to create branch protection", msg)
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// transfer is a helper function to transfer a repository to a new owner. It
+// returns true if the transfer needs to be accepted by the new owner.
+func (r *repositoryResource) transfer(ctx context.Context, owner, name, newOwner string, teamIDs types.Set) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Transfer repository", map[string]any{
+		"owner":     owner,
+		"name":      name,
+		"new_owner": newOwner,
+		"team_ids":  teamIDs.String(),
+	})
+
+	// Generate API request body from plan
+	opts := forgejo.TransferRepoOption{
+		NewOwner: newOwner,
+	}
+	if !teamIDs.IsNull() && !teamIDs.IsUnknown() {
+		var ids []int64
+		diags = teamIDs.ElementsAs(ctx, &ids, false)
+		if diags.HasError() {
+			return false, diags
+		}
+		opts.TeamIDs = &ids
+	}
+
+	// Use Forgejo client to transfer repository
+	rep, res, err := r.client.TransferRepo(owner, name, opts)
+	if err == nil {
+		// Forgejo responds with 201 Created for transfers pending acceptance
+		// and with 202 Accepted for completed transfers. Prefer the owner of
+		// the returned repository over the status code.
+		pending := res.StatusCode == 201
+		if rep != nil && rep.Owner != nil {
+			pending = !strings.EqualFold(rep.Owner.UserName, newOwner)
+		}
+
+		if pending {
+			diags.AddWarning(
+				"Repository transfer pending",
+				fmt.Sprintf(
+					"Transfer of repository '%s/%s' to '%s' must be accepted by the new owner. The repository remains with '%s' until then.",
+					owner,
+					name,
+					newOwner,
+					owner,
+				),
+			)
+
+			return true, diags
+		}
+
+		return false, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 403:
+			msg = fmt.Sprintf(
+				"Transfer of repository with owner '%s' and name '%s' forbidden: %s",
+				owner,
+				name,
+				err,
+			)
+		case 404:
+			msg = fmt.Sprintf(
+				"Repository with owner '%s' and name '%s' or new owner '%s' not found: %s",
+				owner,
+				name,
+				newOwner,
+				err,
+			)
+		case 409:
+			diags.AddWarning(
+				"Repository transfer pending",
+				fmt.Sprintf(
+					"Transfer of repository '%s/%s' is already pending acceptance: %s",
+					owner,
+					name,
+					err,
+				),
+			)
+
+			return true, diags
+		case 422:
+			msg = fmt.Sprintf("Input validation error: %s", err)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to transfer repository", msg)
+
+	return false, diags
+}
@@ -4,6 +4,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -147,7 +148,7 @@ resource "forgejo_repository" "duplicate" {
 				ImportStateId:     forgejoTestUser + "/tftest",
 				ImportStateVerify: true,
 			},
-			// Recreate and Read testing (org repo)
+			// Transfer and Read testing (org repo)
 			{
 				Config: providerConfig + `
 resource "forgejo_organization" "owner" {
@@ -159,7 +160,7 @@ resource "forgejo_repository" "test" {
 }`,
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectResourceAction("forgejo_repository.test", plancheck.ResourceActionReplace),
+						plancheck.ExpectResourceAction("forgejo_repository.test", plancheck.ResourceActionUpdate),
 					},
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -234,7 +235,7 @@ resource "forgejo_repository" "test" {
 					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("website"), knownvalue.StringExact("")),
 				},
 			},
-			// Recreate and Read testing (user repo)
+			// Transfer and Read testing (user repo)
 			{
 				Config: providerConfig + `
 resource "forgejo_user" "owner" {
@@ -248,7 +249,7 @@ resource "forgejo_repository" "test" {
 }`,
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectResourceAction("forgejo_repository.test", plancheck.ResourceActionReplace),
+						plancheck.ExpectResourceAction("forgejo_repository.test", plancheck.ResourceActionUpdate),
 					},
 				},
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -2147,6 +2148,535 @@ import {
 	})
 }
 
+func TestAccRepositoryResourceFork(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing (non-existent source)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "owner" {
+	name = "test_org"
+}
+resource "forgejo_repository" "fork" {
+	owner = forgejo_organization.owner.name
+	name  = "tftest_fork"
+	fork_from = {
+		owner      = "` + forgejoTestUser + `"
+		repository = "non_existent"
+	}
+}`,
+				ExpectError: regexp.MustCompile("Unable to create repository"),
+			},
+			// Create and Read testing (another user's namespace)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "source" {
+	name = "tftest"
+}
+resource "forgejo_repository" "fork" {
+	owner = "non_existent"
+	name  = "tftest_fork"
+	fork_from = {
+		owner      = forgejo_repository.source.owner
+		repository = forgejo_repository.source.name
+	}
+}`,
+				ExpectError: regexp.MustCompile("Repositories can only be forked into an organization"),
+			},
+			// Create and Read testing (org fork)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "owner" {
+	name = "test_org"
+}
+resource "forgejo_repository" "source" {
+	name = "tftest"
+}
+resource "forgejo_repository" "fork" {
+	owner = forgejo_organization.owner.name
+	name  = "tftest_fork"
+	fork_from = {
+		owner      = forgejo_repository.source.owner
+		repository = forgejo_repository.source.name
+	}
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository.fork", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs("forgejo_repository.fork", tfjsonpath.New("parent_id"), "forgejo_repository.source", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue("forgejo_repository.fork", tfjsonpath.New("empty"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("forgejo_repository.fork", tfjsonpath.New("fork"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("forgejo_repository.fork", tfjsonpath.New("full_name"), knownvalue.StringExact("test_org/tftest_fork")),
+					statecheck.ExpectKnownValue("forgejo_repository.fork", tfjsonpath.New("owner"), knownvalue.StringExact("test_org")),
+				},
+			},
+			// Update and Read testing (org fork)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "owner" {
+	name = "test_org"
+}
+resource "forgejo_repository" "source" {
+	name = "tftest"
+}
+resource "forgejo_repository" "fork" {
+	owner       = forgejo_organization.owner.name
+	name        = "tftest_fork"
+	description = "Purely for testing..."
+	fork_from = {
+		owner      = forgejo_repository.source.owner
+		repository = forgejo_repository.source.name
+	}
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository.fork", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository.fork", tfjsonpath.New("description"), knownvalue.StringExact("Purely for testing...")),
+					statecheck.ExpectKnownValue("forgejo_repository.fork", tfjsonpath.New("fork"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("forgejo_repository.fork", tfjsonpath.New("parent_id"), knownvalue.NotNull()),
+				},
+			},
+			// Recreate and Read testing (personal fork)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "owner" {
+	name = "test_org"
+}
+resource "forgejo_repository" "source" {
+	owner = forgejo_organization.owner.name
+	name  = "tftest"
+}
+resource "forgejo_repository" "fork" {
+	name = "tftest_fork"
+	fork_from = {
+		owner      = forgejo_repository.source.owner
+		repository = forgejo_repository.source.name
+	}
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository.fork", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs("forgejo_repository.fork", tfjsonpath.New("parent_id"), "forgejo_repository.source", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue("forgejo_repository.fork", tfjsonpath.New("fork"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("forgejo_repository.fork", tfjsonpath.New("full_name"), knownvalue.StringExact(forgejoTestUser+"/tftest_fork")),
+					statecheck.ExpectKnownValue("forgejo_repository.fork", tfjsonpath.New("owner"), knownvalue.StringExact(forgejoTestUser)),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRepositoryResourceTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing (non-existent template)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "tftest_generated"
+	from_template = {
+		owner      = "` + forgejoTestUser + `"
+		repository = "non_existent"
+	}
+}`,
+				ExpectError: regexp.MustCompile("Unable to create repository"),
+			},
+			// Create and Read testing (personal repo)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "template" {
+	name     = "tftest_template"
+	template = true
+}
+resource "forgejo_branch_protection" "template" {
+	branch_name   = "main"
+	repository_id = forgejo_repository.template.id
+}
+resource "forgejo_repository" "test" {
+	name        = "tftest_generated"
+	description = "Purely for testing..."
+	from_template = {
+		owner              = forgejo_repository.template.owner
+		repository         = forgejo_repository.template.name
+		git_content        = true
+		labels             = true
+		protected_branches = true
+	}
+
+	depends_on = [forgejo_branch_protection.template]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("description"), knownvalue.StringExact("Purely for testing...")),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("empty"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("fork"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("from_template").AtMapKey("avatar"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("from_template").AtMapKey("git_content"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("from_template").AtMapKey("git_hooks"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("from_template").AtMapKey("labels"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("from_template").AtMapKey("protected_branches"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("from_template").AtMapKey("topics"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("from_template").AtMapKey("webhooks"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("full_name"), knownvalue.StringExact(forgejoTestUser+"/tftest_generated")),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("owner"), knownvalue.StringExact(forgejoTestUser)),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("parent_id"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("template"), knownvalue.Bool(false)),
+				},
+			},
+			// Recreate and Read testing (org repo)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "owner" {
+	name = "test_org"
+}
+resource "forgejo_repository" "template" {
+	name     = "tftest_template"
+	template = true
+}
+resource "forgejo_repository" "test" {
+	owner = forgejo_organization.owner.name
+	name  = "tftest_generated"
+	from_template = {
+		owner      = forgejo_repository.template.owner
+		repository = forgejo_repository.template.name
+	}
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("empty"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("from_template").AtMapKey("git_content"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("full_name"), knownvalue.StringExact("test_org/tftest_generated")),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("owner"), knownvalue.StringExact("test_org")),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRepositoryResourceTransfer(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "owner" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	organization_id = forgejo_organization.owner.id
+	name            = "test_team"
+	units = {
+		code = "read"
+	}
+}
+resource "forgejo_repository" "test" {
+	name = "tftest"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("full_name"), knownvalue.StringExact(forgejoTestUser+"/tftest")),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("owner"), knownvalue.StringExact(forgejoTestUser)),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("team_ids"), knownvalue.Null()),
+				},
+			},
+			// Transfer and Read testing (non-existent owner)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "owner" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	organization_id = forgejo_organization.owner.id
+	name            = "test_team"
+	units = {
+		code = "read"
+	}
+}
+resource "forgejo_repository" "test" {
+	owner = "non_existent"
+	name  = "tftest"
+}`,
+				ExpectError: regexp.MustCompile("Unable to transfer repository"),
+			},
+			// Transfer, rename and Read testing (org repo)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "owner" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	organization_id = forgejo_organization.owner.id
+	name            = "test_team"
+	units = {
+		code = "read"
+	}
+}
+resource "forgejo_repository" "test" {
+	owner    = forgejo_organization.owner.name
+	name     = "tftest_transferred"
+	team_ids = [forgejo_team.test.id]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("full_name"), knownvalue.StringExact("test_org/tftest_transferred")),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("name"), knownvalue.StringExact("tftest_transferred")),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("owner"), knownvalue.StringExact("test_org")),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("team_ids"), knownvalue.SetSizeExact(1)),
+				},
+			},
+			// Transfer and Read testing (personal repo)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "owner" {
+	name = "test_org"
+}
+resource "forgejo_repository" "test" {
+	owner = "` + forgejoTestUser + `"
+	name  = "tftest_transferred"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("full_name"), knownvalue.StringExact(forgejoTestUser+"/tftest_transferred")),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("owner"), knownvalue.StringExact(forgejoTestUser)),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRepositoryResourceTransferPending(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing (users)
+			{
+				Config: providerConfig + `
+resource "forgejo_user" "test" {
+	login                = "test_user"
+	email                = "test_user@localhost.localdomain"
+	password             = "P@s$w0rd!"
+	must_change_password = false
+}
+resource "forgejo_user" "test2" {
+	login    = "second_test_user"
+	email    = "second_test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}`,
+			},
+			// Create and Read testing (repo of non-admin user)
+			{
+				Config: providerConfig + `
+provider "forgejo" {
+	alias     = "test_user"
+	host      = "` + forgejoTestHost + `"
+	username  = "test_user"
+	password  = "P@s$w0rd!"
+	api_token = ""
+}
+resource "forgejo_user" "test" {
+	login                = "test_user"
+	email                = "test_user@localhost.localdomain"
+	password             = "P@s$w0rd!"
+	must_change_password = false
+}
+resource "forgejo_user" "test2" {
+	login    = "second_test_user"
+	email    = "second_test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+resource "forgejo_repository" "test" {
+	provider   = forgejo.test_user
+	name       = "tftest"
+	depends_on = [forgejo_user.test, forgejo_user.test2]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("owner"), knownvalue.StringExact("test_user")),
+				},
+			},
+			// Transfer testing (pending acceptance by other user)
+			{
+				Config: providerConfig + `
+provider "forgejo" {
+	alias     = "test_user"
+	host      = "` + forgejoTestHost + `"
+	username  = "test_user"
+	password  = "P@s$w0rd!"
+	api_token = ""
+}
+resource "forgejo_user" "test" {
+	login                = "test_user"
+	email                = "test_user@localhost.localdomain"
+	password             = "P@s$w0rd!"
+	must_change_password = false
+}
+resource "forgejo_user" "test2" {
+	login    = "second_test_user"
+	email    = "second_test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+resource "forgejo_repository" "test" {
+	provider   = forgejo.test_user
+	owner      = "second_test_user"
+	name       = "tftest"
+	depends_on = [forgejo_user.test, forgejo_user.test2]
+}`,
+				ExpectError: regexp.MustCompile("Repository owner not changed"),
+			},
+			// Read testing (current owner kept in state)
+			{
+				Config: providerConfig + `
+provider "forgejo" {
+	alias     = "test_user"
+	host      = "` + forgejoTestHost + `"
+	username  = "test_user"
+	password  = "P@s$w0rd!"
+	api_token = ""
+}
+resource "forgejo_user" "test" {
+	login                = "test_user"
+	email                = "test_user@localhost.localdomain"
+	password             = "P@s$w0rd!"
+	must_change_password = false
+}
+resource "forgejo_user" "test2" {
+	login    = "second_test_user"
+	email    = "second_test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+resource "forgejo_repository" "test" {
+	provider   = forgejo.test_user
+	owner      = "test_user"
+	name       = "tftest"
+	depends_on = [forgejo_user.test, forgejo_user.test2]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("full_name"), knownvalue.StringExact("test_user/tftest")),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("owner"), knownvalue.StringExact("test_user")),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRepositoryResourcePreventDestroy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing (empty repo)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "empty" {
+	name                         = "tftest_empty"
+	auto_init                    = false
+	prevent_destroy_if_not_empty = true
+}
+resource "forgejo_repository" "test" {
+	name                         = "tftest"
+	prevent_destroy_if_not_empty = true
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository.empty", plancheck.ResourceActionCreate),
+						plancheck.ExpectResourceAction("forgejo_repository.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository.empty", tfjsonpath.New("empty"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("forgejo_repository.empty", tfjsonpath.New("prevent_destroy_if_not_empty"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("empty"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("prevent_destroy_if_not_empty"), knownvalue.Bool(true)),
+				},
+			},
+			// Delete testing (empty repo)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name                         = "tftest"
+	prevent_destroy_if_not_empty = true
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository.empty", plancheck.ResourceActionDestroy),
+					},
+				},
+			},
+			// Delete testing (non-empty repo)
+			{
+				Config:      providerConfig,
+				ExpectError: regexp.MustCompile("it has commits"),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name                         = "tftest"
+	prevent_destroy_if_not_empty = false
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_repository.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_repository.test", tfjsonpath.New("prevent_destroy_if_not_empty"), knownvalue.Bool(false)),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func TestAccRepositoryValidationPullRequestConfig(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -2309,6 +2839,34 @@ resource "forgejo_repository" "test" {
 				PlanOnly:    true,
 				ExpectError: regexp.MustCompile("If enable_prune is configured, mirror must be 'true'"),
 			},
+			// Check from_template attribute
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name       = "test_repo_mirror_validation"
+	clone_addr = "https://example.com/repo.git"
+	from_template = {
+		owner      = "` + forgejoTestUser + `"
+		repository = "tftest"
+	}
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Attribute \"clone_addr\" cannot be specified when \"from_template\" is\\s+specified"),
+			},
+			// Check fork_from attribute
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name       = "test_repo_mirror_validation"
+	clone_addr = "https://example.com/repo.git"
+	fork_from = {
+		owner      = "` + forgejoTestUser + `"
+		repository = "tftest"
+	}
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Attribute \"clone_addr\" cannot be specified when \"fork_from\" is\\s+specified"),
+			},
 			// Check lfs_endpoint attribute
 			{
 				Config: providerConfig + `
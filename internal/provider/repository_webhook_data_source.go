@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &repositoryWebhookDataSource{}
+	_ datasource.DataSourceWithConfigure = &repositoryWebhookDataSource{}
+)
+
+// repositoryWebhookDataSource is the data source implementation.
+type repositoryWebhookDataSource struct {
+	client *forgejo.Client
+}
+
+// repositoryWebhookDataSourceModel maps the data source schema data.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#Hook
+type repositoryWebhookDataSourceModel struct {
+	RepositoryID types.Int64  `tfsdk:"repository_id"`
+	WebhookID    types.Int64  `tfsdk:"webhook_id"`
+	Active       types.Bool   `tfsdk:"active"`
+	Config       types.Map    `tfsdk:"config"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	Events       types.Set    `tfsdk:"events"`
+	Type         types.String `tfsdk:"type"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+func (m repositoryWebhookDataSourceModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"repository_id": types.Int64Type,
+		"webhook_id":    types.Int64Type,
+		"active":        types.BoolType,
+		"config":        types.MapType{ElemType: types.StringType},
+		"created_at":    types.StringType,
+		"events":        types.SetType{ElemType: types.StringType},
+		"type":          types.StringType,
+		"updated_at":    types.StringType,
+	}
+}
+
+// from is a helper function to load an API struct into Terraform data model.
+func (m *repositoryWebhookDataSourceModel) from(ctx context.Context, h *forgejo.Hook) (diags diag.Diagnostics) {
+	if h == nil {
+		return diags
+	}
+
+	var d diag.Diagnostics
+
+	m.WebhookID = types.Int64Value(h.ID)
+	m.Active = types.BoolValue(h.Active)
+	// Obfuscate any write-only config keys (e.g. "secret") the API might echo back
+	m.Config, d = types.MapValueFrom(ctx, types.StringType, redactRepositoryWebhookConfig(h.Config))
+	diags.Append(d...)
+	m.CreatedAt = types.StringValue(h.Created.Format(time.RFC3339))
+	m.Events, d = types.SetValueFrom(ctx, types.StringType, h.Events)
+	diags.Append(d...)
+	m.Type = types.StringValue(h.Type)
+	m.UpdatedAt = types.StringValue(h.Updated.Format(time.RFC3339))
+
+	return diags
+}
+
+// Metadata returns the data source type name.
+func (d *repositoryWebhookDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_webhook"
+}
+
+// Schema defines the schema for the data source.
+func (d *repositoryWebhookDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := repositoryWebhookDataSourceAttributes()
+	attributes["repository_id"] = schema.Int64Attribute{
+		Description: "Numeric identifier of the repository.",
+		Required:    true,
+	}
+	attributes["webhook_id"] = schema.Int64Attribute{
+		Description: "Numeric identifier of the webhook.",
+		Required:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Forgejo repository webhook data source.",
+
+		Attributes: attributes,
+	}
+}
+
+// repositoryWebhookDataSourceAttributes returns the computed repository
+// webhook attributes shared by the repository webhook and repository webhooks
+// data sources.
+func repositoryWebhookDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"repository_id": schema.Int64Attribute{
+			Description: "Numeric identifier of the repository.",
+			Computed:    true,
+		},
+		"webhook_id": schema.Int64Attribute{
+			Description: "Numeric identifier of the webhook.",
+			Computed:    true,
+		},
+		"active": schema.BoolAttribute{
+			Description: "Boolean indicating if the webhook is active.",
+			Computed:    true,
+		},
+		"config": schema.MapAttribute{
+			Description: "Map of configuration settings, e.g. \"content_type\" and \"url\". Values of write-only keys such as \"secret\" are obfuscated.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "Time at which the webhook was created.",
+			Computed:    true,
+		},
+		"events": schema.SetAttribute{
+			Description: "List of events which trigger the webhook.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "Type of webhook.",
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "Time at which the webhook was updated.",
+			Computed:    true,
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *repositoryWebhookDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *repositoryWebhookDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer un(trace(ctx, "Read repository webhook data source"))
+
+	var data repositoryWebhookDataSourceModel
+
+	// Read Terraform configuration data into model
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository by id
+	rep, diags := getRepositoryByID(
+		ctx,
+		d.client,
+		data.RepositoryID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository webhook
+	hook, diags := getRepositoryWebhook(
+		ctx,
+		d.client,
+		rep.Owner.UserName,
+		rep.Name,
+		data.WebhookID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	diags = data.from(ctx, hook)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewRepositoryWebhookDataSource is a helper function to simplify the provider implementation.
+func NewRepositoryWebhookDataSource() datasource.DataSource {
+	return &repositoryWebhookDataSource{}
+}
+
+// getRepositoryWebhook fetches a repository webhook by its ID and handles errors consistently.
+func getRepositoryWebhook(ctx context.Context, client *forgejo.Client, owner, repo string, id int64) (*forgejo.Hook, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Read repository webhook", map[string]any{
+		"owner":      owner,
+		"repo":       repo,
+		"webhook_id": id,
+	})
+
+	// Use Forgejo client to get repository webhook
+	hook, res, err := client.GetRepoHook(owner, repo, id)
+	if err == nil {
+		return hook, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 404:
+			msg = fmt.Sprintf(
+				"Repository webhook with owner '%s', repo '%s' and ID %d not found: %s",
+				owner,
+				repo,
+				id,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to read repository webhook", msg)
+
+	return nil, diags
+}
@@ -0,0 +1,82 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccRepositoryWebhookDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (non-existent repository)
+			{
+				Config: providerConfig + `
+data "forgejo_repository_webhook" "test" {
+	repository_id = -1
+	webhook_id    = 1
+}`,
+				ExpectError: regexp.MustCompile("Repository with ID -1 not found"),
+			},
+			// Read testing (non-existent webhook)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+data "forgejo_repository_webhook" "test" {
+	repository_id = forgejo_repository.test.id
+	webhook_id    = 1011
+}`,
+				ExpectError: regexp.MustCompile("Repository webhook with owner '" + forgejoTestUser + "', repo 'test_repo'\\s+and\\s+ID\\s+1011\\s+not\\s+found"),
+			},
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+resource "forgejo_repository_webhook" "test" {
+	repository_id = forgejo_repository.test.id
+	type          = "forgejo"
+	active        = true
+	events        = ["push", "release"]
+	config        = {
+		"content_type" = "json"
+		"url"          = "http://example.com/abc12345"
+		"secret"       = "supersecret"
+	}
+}
+data "forgejo_repository_webhook" "test" {
+	repository_id = forgejo_repository.test.id
+	webhook_id    = forgejo_repository_webhook.test.webhook_id
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("data.forgejo_repository_webhook.test", plancheck.ResourceActionRead),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs("data.forgejo_repository_webhook.test", tfjsonpath.New("webhook_id"), "forgejo_repository_webhook.test", tfjsonpath.New("webhook_id"), compare.ValuesSame()),
+					statecheck.CompareValuePairs("data.forgejo_repository_webhook.test", tfjsonpath.New("created_at"), "forgejo_repository_webhook.test", tfjsonpath.New("created_at"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue("data.forgejo_repository_webhook.test", tfjsonpath.New("active"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("data.forgejo_repository_webhook.test", tfjsonpath.New("type"), knownvalue.StringExact("forgejo")),
+					statecheck.ExpectKnownValue("data.forgejo_repository_webhook.test", tfjsonpath.New("events"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("push"), knownvalue.StringExact("release")})),
+					statecheck.ExpectKnownValue("data.forgejo_repository_webhook.test", tfjsonpath.New("updated_at"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("data.forgejo_repository_webhook.test", tfjsonpath.New("config"), knownvalue.MapPartial(map[string]knownvalue.Check{
+						"content_type": knownvalue.StringExact("json"),
+						"url":          knownvalue.StringExact("http://example.com/abc12345"),
+					})),
+				},
+			},
+		},
+	})
+}
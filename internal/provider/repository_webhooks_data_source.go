@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &repositoryWebhooksDataSource{}
+	_ datasource.DataSourceWithConfigure = &repositoryWebhooksDataSource{}
+)
+
+// repositoryWebhooksDataSource is the data source implementation.
+type repositoryWebhooksDataSource struct {
+	client *forgejo.Client
+}
+
+// repositoryWebhooksDataSourceModel maps the data source schema data.
+type repositoryWebhooksDataSourceModel struct {
+	RepositoryID types.Int64 `tfsdk:"repository_id"`
+	Webhooks     types.List  `tfsdk:"webhooks"`
+}
+
+// Metadata returns the data source type name.
+func (d *repositoryWebhooksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_webhooks"
+}
+
+// Schema defines the schema for the data source.
+func (d *repositoryWebhooksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forgejo repository webhooks data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"repository_id": schema.Int64Attribute{
+				Description: "Numeric identifier of the repository.",
+				Required:    true,
+			},
+			"webhooks": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: repositoryWebhookDataSourceAttributes(),
+				},
+				Description: "Webhooks of the repository, sorted by webhook ID.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *repositoryWebhooksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *repositoryWebhooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer un(trace(ctx, "Read repository webhooks data source"))
+
+	var data repositoryWebhooksDataSourceModel
+
+	// Read Terraform configuration data into model
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository by id
+	rep, diags := getRepositoryByID(
+		ctx,
+		d.client,
+		data.RepositoryID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to list repository webhooks
+	hooks, diags := listRepositoryWebhooks(
+		ctx,
+		d.client,
+		rep.Owner.UserName,
+		rep.Name,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sort webhooks by ID for a stable order
+	slices.SortFunc(hooks, func(a, b *forgejo.Hook) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	// Map response body to model
+	webhooks := make([]repositoryWebhookDataSourceModel, len(hooks))
+	for i, hook := range hooks {
+		webhooks[i].RepositoryID = data.RepositoryID
+		diags = webhooks[i].from(ctx, hook)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	data.Webhooks, diags = types.ListValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: repositoryWebhookDataSourceModel{}.attributeTypes()},
+		webhooks,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewRepositoryWebhooksDataSource is a helper function to simplify the provider implementation.
+func NewRepositoryWebhooksDataSource() datasource.DataSource {
+	return &repositoryWebhooksDataSource{}
+}
+
+// listRepositoryWebhooks is a helper function to list all webhooks of a repository.
+func listRepositoryWebhooks(ctx context.Context, client *forgejo.Client, owner, repo string) ([]*forgejo.Hook, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "List repository webhooks", map[string]any{
+		"owner": owner,
+		"repo":  repo,
+	})
+
+	// Use Forgejo client to list repository webhooks
+	hooks, res, err := listAllPages(func(opts forgejo.ListOptions) ([]*forgejo.Hook, *forgejo.Response, error) {
+		return client.ListRepoHooks(owner, repo, forgejo.ListHooksOptions{
+			ListOptions: opts,
+		})
+	})
+	if err == nil {
+		return hooks, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 403:
+			msg = fmt.Sprintf(
+				"Repository webhooks with owner '%s' and repo '%s' forbidden: %s",
+				owner,
+				repo,
+				err,
+			)
+		case 404:
+			msg = fmt.Sprintf(
+				"Repository with owner '%s' and name '%s' not found: %s",
+				owner,
+				repo,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to list repository webhooks", msg)
+
+	return nil, diags
+}
@@ -0,0 +1,98 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccRepositoryWebhooksDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (non-existent repository)
+			{
+				Config: providerConfig + `
+data "forgejo_repository_webhooks" "test" {
+	repository_id = -1
+}`,
+				ExpectError: regexp.MustCompile("Repository with ID -1 not found"),
+			},
+			// Read testing (no webhooks)
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+data "forgejo_repository_webhooks" "test" {
+	repository_id = forgejo_repository.test.id
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.forgejo_repository_webhooks.test", tfjsonpath.New("webhooks"), knownvalue.ListSizeExact(0)),
+				},
+			},
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_repository" "test" {
+	name = "test_repo"
+}
+resource "forgejo_repository_webhook" "ci" {
+	repository_id = forgejo_repository.test.id
+	type          = "forgejo"
+	active        = true
+	config        = {
+		"content_type" = "json"
+		"url"          = "http://ci.example.com/hook"
+	}
+}
+resource "forgejo_repository_webhook" "chat" {
+	repository_id = forgejo_repository.test.id
+	type          = "slack"
+	config        = {
+		"content_type" = "json"
+		"url"          = "http://chat.example.com/hook"
+		"channel"      = "#dev"
+	}
+	depends_on    = [forgejo_repository_webhook.ci]
+}
+data "forgejo_repository_webhooks" "test" {
+	repository_id = forgejo_repository.test.id
+	depends_on    = [forgejo_repository_webhook.ci, forgejo_repository_webhook.chat]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("data.forgejo_repository_webhooks.test", plancheck.ResourceActionRead),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.forgejo_repository_webhooks.test", tfjsonpath.New("webhooks"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"repository_id": knownvalue.NotNull(),
+							"webhook_id":    knownvalue.NotNull(),
+							"active":        knownvalue.Bool(true),
+							"type":          knownvalue.StringExact("forgejo"),
+							"events":        knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("push")}),
+							"config": knownvalue.MapPartial(map[string]knownvalue.Check{
+								"url": knownvalue.StringExact("http://ci.example.com/hook"),
+							}),
+						}),
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"active": knownvalue.Bool(false),
+							"type":   knownvalue.StringExact("slack"),
+							"config": knownvalue.MapPartial(map[string]knownvalue.Check{
+								"url": knownvalue.StringExact("http://chat.example.com/hook"),
+							}),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
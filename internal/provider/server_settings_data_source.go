@@ -0,0 +1,389 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serverSettingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &serverSettingsDataSource{}
+)
+
+// serverSettingsDataSource is the data source implementation.
+type serverSettingsDataSource struct {
+	client *forgejo.Client
+}
+
+// serverSettingsDataSourceModel maps the data source schema data.
+type serverSettingsDataSourceModel struct {
+	API        types.Object `tfsdk:"api"`
+	Repository types.Object `tfsdk:"repository"`
+	UI         types.Object `tfsdk:"ui"`
+	Attachment types.Object `tfsdk:"attachment"`
+}
+
+// serverSettingsDataSourceAPI maps the global API settings.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#GlobalAPISettings
+type serverSettingsDataSourceAPI struct {
+	MaxResponseItems       types.Int64 `tfsdk:"max_response_items"`
+	DefaultPagingNum       types.Int64 `tfsdk:"default_paging_num"`
+	DefaultGitTreesPerPage types.Int64 `tfsdk:"default_git_trees_per_page"`
+	DefaultMaxBlobSize     types.Int64 `tfsdk:"default_max_blob_size"`
+}
+
+func (m serverSettingsDataSourceAPI) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"max_response_items":         types.Int64Type,
+		"default_paging_num":         types.Int64Type,
+		"default_git_trees_per_page": types.Int64Type,
+		"default_max_blob_size":      types.Int64Type,
+	}
+}
+
+// from is a helper function to load an API struct into Terraform data model.
+func (m *serverSettingsDataSourceAPI) from(s *forgejo.GlobalAPISettings) {
+	m.MaxResponseItems = types.Int64Value(int64(s.MaxResponseItems))
+	m.DefaultPagingNum = types.Int64Value(int64(s.DefaultPagingNum))
+	m.DefaultGitTreesPerPage = types.Int64Value(int64(s.DefaultGitTreesPerPage))
+	m.DefaultMaxBlobSize = types.Int64Value(s.DefaultMaxBlobSize)
+}
+
+// serverSettingsDataSourceRepository maps the global repository settings.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#GlobalRepoSettings
+type serverSettingsDataSourceRepository struct {
+	MirrorsDisabled      types.Bool `tfsdk:"mirrors_disabled"`
+	HTTPGitDisabled      types.Bool `tfsdk:"http_git_disabled"`
+	MigrationsDisabled   types.Bool `tfsdk:"migrations_disabled"`
+	StarsDisabled        types.Bool `tfsdk:"stars_disabled"`
+	TimeTrackingDisabled types.Bool `tfsdk:"time_tracking_disabled"`
+	LFSDisabled          types.Bool `tfsdk:"lfs_disabled"`
+}
+
+func (m serverSettingsDataSourceRepository) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"mirrors_disabled":       types.BoolType,
+		"http_git_disabled":      types.BoolType,
+		"migrations_disabled":    types.BoolType,
+		"stars_disabled":         types.BoolType,
+		"time_tracking_disabled": types.BoolType,
+		"lfs_disabled":           types.BoolType,
+	}
+}
+
+// from is a helper function to load an API struct into Terraform data model.
+func (m *serverSettingsDataSourceRepository) from(s *forgejo.GlobalRepoSettings) {
+	m.MirrorsDisabled = types.BoolValue(s.MirrorsDisabled)
+	m.HTTPGitDisabled = types.BoolValue(s.HTTPGitDisabled)
+	m.MigrationsDisabled = types.BoolValue(s.MigrationsDisabled)
+	m.StarsDisabled = types.BoolValue(s.StarsDisabled)
+	m.TimeTrackingDisabled = types.BoolValue(s.TimeTrackingDisabled)
+	m.LFSDisabled = types.BoolValue(s.LFSDisabled)
+}
+
+// serverSettingsDataSourceUI maps the global UI settings.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#GlobalUISettings
+type serverSettingsDataSourceUI struct {
+	DefaultTheme     types.String `tfsdk:"default_theme"`
+	AllowedReactions types.List   `tfsdk:"allowed_reactions"`
+	CustomEmojis     types.List   `tfsdk:"custom_emojis"`
+}
+
+func (m serverSettingsDataSourceUI) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"default_theme":     types.StringType,
+		"allowed_reactions": types.ListType{ElemType: types.StringType},
+		"custom_emojis":     types.ListType{ElemType: types.StringType},
+	}
+}
+
+// from is a helper function to load an API struct into Terraform data model.
+func (m *serverSettingsDataSourceUI) from(ctx context.Context, s *forgejo.GlobalUISettings) (diags diag.Diagnostics) {
+	var d diag.Diagnostics
+
+	m.DefaultTheme = types.StringValue(s.DefaultTheme)
+	m.AllowedReactions, d = types.ListValueFrom(ctx, types.StringType, append([]string{}, s.AllowedReactions...))
+	diags.Append(d...)
+	m.CustomEmojis, d = types.ListValueFrom(ctx, types.StringType, append([]string{}, s.CustomEmojis...))
+	diags.Append(d...)
+
+	return diags
+}
+
+// serverSettingsDataSourceAttachment maps the global attachment settings.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#GlobalAttachmentSettings
+type serverSettingsDataSourceAttachment struct {
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	AllowedTypes types.String `tfsdk:"allowed_types"`
+	MaxSize      types.Int64  `tfsdk:"max_size"`
+	MaxFiles     types.Int64  `tfsdk:"max_files"`
+}
+
+func (m serverSettingsDataSourceAttachment) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":       types.BoolType,
+		"allowed_types": types.StringType,
+		"max_size":      types.Int64Type,
+		"max_files":     types.Int64Type,
+	}
+}
+
+// from is a helper function to load an API struct into Terraform data model.
+func (m *serverSettingsDataSourceAttachment) from(s *forgejo.GlobalAttachmentSettings) {
+	m.Enabled = types.BoolValue(s.Enabled)
+	m.AllowedTypes = types.StringValue(s.AllowedTypes)
+	m.MaxSize = types.Int64Value(s.MaxSize)
+	m.MaxFiles = types.Int64Value(int64(s.MaxFiles))
+}
+
+// Metadata returns the data source type name.
+func (d *serverSettingsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_settings"
+}
+
+// Schema defines the schema for the data source.
+func (d *serverSettingsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo server settings data source.
+
+Returns the API, repository, UI and attachment settings the Forgejo instance exposes, so configurations can adapt to the instance.
+
+**Note**: Forgejo does not expose whether cloning via SSH is enabled.`,
+
+		Attributes: map[string]schema.Attribute{
+			"api": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"max_response_items": schema.Int64Attribute{
+						Description: "Maximum number of items returned per page.",
+						Computed:    true,
+					},
+					"default_paging_num": schema.Int64Attribute{
+						Description: "Default number of items returned per page.",
+						Computed:    true,
+					},
+					"default_git_trees_per_page": schema.Int64Attribute{
+						Description: "Default number of Git tree items returned per page.",
+						Computed:    true,
+					},
+					"default_max_blob_size": schema.Int64Attribute{
+						Description: "Default maximum size of blobs returned by the contents API (in bytes).",
+						Computed:    true,
+					},
+				},
+				Description: "API settings.",
+				Computed:    true,
+			},
+			"repository": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"mirrors_disabled": schema.BoolAttribute{
+						Description: "Are mirrors disabled?",
+						Computed:    true,
+					},
+					"http_git_disabled": schema.BoolAttribute{
+						Description: "Is Git access via HTTP(S) disabled?",
+						Computed:    true,
+					},
+					"migrations_disabled": schema.BoolAttribute{
+						Description: "Are migrations disabled?",
+						Computed:    true,
+					},
+					"stars_disabled": schema.BoolAttribute{
+						Description: "Are stars disabled?",
+						Computed:    true,
+					},
+					"time_tracking_disabled": schema.BoolAttribute{
+						Description: "Is time tracking disabled?",
+						Computed:    true,
+					},
+					"lfs_disabled": schema.BoolAttribute{
+						Description: "Is Git LFS disabled?",
+						Computed:    true,
+					},
+				},
+				Description: "Repository settings.",
+				Computed:    true,
+			},
+			"ui": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"default_theme": schema.StringAttribute{
+						Description: "Default theme of the web interface.",
+						Computed:    true,
+					},
+					"allowed_reactions": schema.ListAttribute{
+						Description: "Reactions allowed on issues, pull requests and comments.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"custom_emojis": schema.ListAttribute{
+						Description: "Custom emojis available in addition to the default ones.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+				},
+				Description: "UI settings.",
+				Computed:    true,
+			},
+			"attachment": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description: "Are attachments enabled?",
+						Computed:    true,
+					},
+					"allowed_types": schema.StringAttribute{
+						Description: "Allowed file extensions and MIME types of attachments (comma-separated).",
+						Computed:    true,
+					},
+					"max_size": schema.Int64Attribute{
+						Description: "Maximum size of an attachment (in megabytes).",
+						Computed:    true,
+					},
+					"max_files": schema.Int64Attribute{
+						Description: "Maximum number of attachments per upload.",
+						Computed:    true,
+					},
+				},
+				Description: "Attachment settings.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serverSettingsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serverSettingsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer un(trace(ctx, "Read server settings data source"))
+
+	var (
+		data       serverSettingsDataSourceModel
+		api        serverSettingsDataSourceAPI
+		repository serverSettingsDataSourceRepository
+		ui         serverSettingsDataSourceUI
+		attachment serverSettingsDataSourceAttachment
+	)
+
+	// Use Forgejo client to get API settings
+	apiSettings, diags := getServerSettings(ctx, "API", d.client.GetGlobalAPISettings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	api.from(apiSettings)
+
+	// Use Forgejo client to get repository settings
+	repoSettings, diags := getServerSettings(ctx, "repository", d.client.GetGlobalRepoSettings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	repository.from(repoSettings)
+
+	// Use Forgejo client to get UI settings
+	uiSettings, diags := getServerSettings(ctx, "UI", d.client.GetGlobalUISettings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = ui.from(ctx, uiSettings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get attachment settings
+	attachmentSettings, diags := getServerSettings(ctx, "attachment", d.client.GetGlobalAttachmentSettings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	attachment.from(attachmentSettings)
+
+	// Map response bodies to model
+	data.API, diags = types.ObjectValueFrom(ctx, api.attributeTypes(), api)
+	resp.Diagnostics.Append(diags...)
+	data.Repository, diags = types.ObjectValueFrom(ctx, repository.attributeTypes(), repository)
+	resp.Diagnostics.Append(diags...)
+	data.UI, diags = types.ObjectValueFrom(ctx, ui.attributeTypes(), ui)
+	resp.Diagnostics.Append(diags...)
+	data.Attachment, diags = types.ObjectValueFrom(ctx, attachment.attributeTypes(), attachment)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewServerSettingsDataSource is a helper function to simplify the provider implementation.
+func NewServerSettingsDataSource() datasource.DataSource {
+	return &serverSettingsDataSource{}
+}
+
+// getServerSettings is a helper function to fetch one category of global
+// server settings and handle errors consistently.
+func getServerSettings[T any](ctx context.Context, category string, get func() (*T, *forgejo.Response, error)) (*T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Read server settings", map[string]any{
+		"category": category,
+	})
+
+	// Use Forgejo client to get server settings
+	settings, res, err := get()
+	if err == nil {
+		return settings, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		msg = fmt.Sprintf(
+			"Unknown error (status %d): %s",
+			res.StatusCode,
+			err,
+		)
+	}
+	diags.AddError(fmt.Sprintf("Unable to read %s settings", category), msg)
+
+	return nil, diags
+}
@@ -0,0 +1,43 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccServerSettingsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "forgejo_server_settings" "test" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.forgejo_server_settings.test", tfjsonpath.New("api"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"max_response_items":         knownvalue.Int64Exact(50),
+						"default_paging_num":         knownvalue.Int64Exact(30),
+						"default_git_trees_per_page": knownvalue.NotNull(),
+						"default_max_blob_size":      knownvalue.NotNull(),
+					})),
+					statecheck.ExpectKnownValue("data.forgejo_server_settings.test", tfjsonpath.New("repository"), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"http_git_disabled": knownvalue.Bool(false),
+						"mirrors_disabled":  knownvalue.Bool(false),
+						"lfs_disabled":      knownvalue.Bool(false),
+					})),
+					statecheck.ExpectKnownValue("data.forgejo_server_settings.test", tfjsonpath.New("ui").AtMapKey("allowed_reactions"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("data.forgejo_server_settings.test", tfjsonpath.New("attachment"), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"enabled":   knownvalue.Bool(true),
+						"max_size":  knownvalue.NotNull(),
+						"max_files": knownvalue.NotNull(),
+					})),
+				},
+			},
+		},
+	})
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -80,6 +81,8 @@ func (r *sshKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Forgejo user SSH key resource.
 
+SSH keys are installed for the given ` + "`user`" + `, which allows distributing keys for arbitrary users from a single provider configuration.
+
 **Note**: Managing user SSH keys requires administrative privileges!`,
 
 		Attributes: map[string]schema.Attribute{
@@ -272,8 +275,14 @@ func (r *sshKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		"key_id": data.KeyID.ValueInt64(),
 	})
 
-	// Use Forgejo client to get SSH key
-	key, res, err := r.client.GetPublicKey(data.KeyID.ValueInt64())
+	// Use Forgejo client to list SSH keys of user
+	// (GetPublicKey only returns keys of the authenticated user)
+	keys, res, err := listAllPages(func(opts forgejo.ListOptions) ([]*forgejo.PublicKey, *forgejo.Response, error) {
+		return r.client.ListPublicKeys(
+			data.User.ValueString(),
+			forgejo.ListPublicKeysOptions{ListOptions: opts},
+		)
+	})
 	if err != nil {
 		var msg string
 		if res == nil {
@@ -311,6 +320,24 @@ func (r *sshKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	// Search for SSH key with given ID
+	idx := slices.IndexFunc(keys, func(k *forgejo.PublicKey) bool {
+		return k.ID == data.KeyID.ValueInt64()
+	})
+	if idx == -1 {
+		resp.Diagnostics.AddError(
+			"Unable to read SSH key",
+			fmt.Sprintf(
+				"SSH key with user %s and ID %d not found",
+				data.User.String(),
+				data.KeyID.ValueInt64(),
+			),
+		)
+
+		return
+	}
+	key := keys[idx]
+
 	// Map response body to model
 	data.from(key)
 
@@ -145,3 +145,45 @@ resource "forgejo_ssh_key" "test" {
 		},
 	})
 }
+
+func TestAccSSHKeyResourceOtherUser(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"tls": {
+				Source: "hashicorp/tls",
+			},
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_user" "test" {
+	login    = "test_user"
+	email    = "test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+resource "tls_private_key" "test" {
+	algorithm = "ED25519"
+}
+resource "forgejo_ssh_key" "test" {
+	user  = forgejo_user.test.login
+	key   = trimspace(tls_private_key.test.public_key_openssh)
+	title = "tftest"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_ssh_key.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_ssh_key.test", tfjsonpath.New("key_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("forgejo_ssh_key.test", tfjsonpath.New("title"), knownvalue.StringExact("tftest")),
+					statecheck.ExpectKnownValue("forgejo_ssh_key.test", tfjsonpath.New("user"), knownvalue.StringExact("test_user")),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
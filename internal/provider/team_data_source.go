@@ -40,7 +40,7 @@ type teamDataSourceModel struct {
 	Description             types.String `tfsdk:"description"`
 	IncludesAllRepositories types.Bool   `tfsdk:"includes_all_repositories"`
 	Permission              types.String `tfsdk:"permission"`
-	UnitsMap                types.Map    `tfsdk:"units_map"`
+	Units                   types.Object `tfsdk:"units"`
 }
 
 // Metadata returns the data source type name.
@@ -98,11 +98,7 @@ func (d *teamDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 				Description: "Permissions within the owning organization.",
 				Computed:    true,
 			},
-			"units_map": schema.MapAttribute{
-				Description: "Map of access units.",
-				ElementType: types.StringType,
-				Computed:    true,
-			},
+			"units": teamUnitsDataSourceAttribute(),
 		},
 	}
 }
@@ -187,7 +183,10 @@ func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	data.Permission = types.StringValue(string(team.Permission))
 	data.CanCreateOrgRepo = types.BoolValue(team.CanCreateOrgRepo)
 	data.IncludesAllRepositories = types.BoolValue(team.IncludesAllRepositories)
-	data.UnitsMap, diags = types.MapValueFrom(ctx, types.StringType, team.UnitsMap)
+
+	var units teamResourceUnits
+	units.from(team.UnitsMap)
+	data.Units, diags = types.ObjectValueFrom(ctx, units.attributeTypes(), units)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -63,8 +63,8 @@ resource "forgejo_team" "test_by_id" {
 	can_create_org_repo       = true
 	includes_all_repositories = true
 	permission                = "read"
-	units_map                 = {
-		"repo.code" = "read"
+	units                     = {
+		code = "read"
 	}
 }
 data "forgejo_team" "test_by_id" {
@@ -77,8 +77,8 @@ resource "forgejo_team" "test_by_name" {
 	can_create_org_repo       = true
 	includes_all_repositories = true
 	permission                = "read"
-	units_map                 = {
-		"repo.code" = "read"
+	units                     = {
+		code = "read"
 	}
 }
 data "forgejo_team" "test_by_name" {
@@ -98,18 +98,16 @@ data "forgejo_team" "test_by_name" {
 					statecheck.ExpectKnownValue("data.forgejo_team.test_by_id", tfjsonpath.New("can_create_org_repo"), knownvalue.Bool(true)),
 					statecheck.ExpectKnownValue("data.forgejo_team.test_by_id", tfjsonpath.New("includes_all_repositories"), knownvalue.Bool(true)),
 					statecheck.ExpectKnownValue("data.forgejo_team.test_by_id", tfjsonpath.New("permission"), knownvalue.StringExact("read")),
-					statecheck.ExpectKnownValue("data.forgejo_team.test_by_id", tfjsonpath.New("units_map"), knownvalue.MapExact(map[string]knownvalue.Check{
-						"repo.code": knownvalue.StringExact("read"),
-					})),
+					statecheck.ExpectKnownValue("data.forgejo_team.test_by_id", tfjsonpath.New("units").AtMapKey("code"), knownvalue.StringExact("read")),
+					statecheck.ExpectKnownValue("data.forgejo_team.test_by_id", tfjsonpath.New("units").AtMapKey("issues"), knownvalue.StringExact("none")),
 					statecheck.ExpectKnownValue("data.forgejo_team.test_by_name", tfjsonpath.New("name"), knownvalue.StringExact("test_team_by_name")),
 					statecheck.ExpectKnownValue("data.forgejo_team.test_by_name", tfjsonpath.New("organization"), knownvalue.StringExact("test_org")),
 					statecheck.ExpectKnownValue("data.forgejo_team.test_by_name", tfjsonpath.New("organization_id"), knownvalue.Null()),
 					statecheck.ExpectKnownValue("data.forgejo_team.test_by_name", tfjsonpath.New("can_create_org_repo"), knownvalue.Bool(true)),
 					statecheck.ExpectKnownValue("data.forgejo_team.test_by_name", tfjsonpath.New("includes_all_repositories"), knownvalue.Bool(true)),
 					statecheck.ExpectKnownValue("data.forgejo_team.test_by_name", tfjsonpath.New("permission"), knownvalue.StringExact("read")),
-					statecheck.ExpectKnownValue("data.forgejo_team.test_by_name", tfjsonpath.New("units_map"), knownvalue.MapExact(map[string]knownvalue.Check{
-						"repo.code": knownvalue.StringExact("read"),
-					})),
+					statecheck.ExpectKnownValue("data.forgejo_team.test_by_name", tfjsonpath.New("units").AtMapKey("code"), knownvalue.StringExact("read")),
+					statecheck.ExpectKnownValue("data.forgejo_team.test_by_name", tfjsonpath.New("units").AtMapKey("issues"), knownvalue.StringExact("none")),
 				},
 			},
 		},
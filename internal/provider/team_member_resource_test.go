@@ -42,8 +42,8 @@ resource "forgejo_team" "test" {
 	can_create_org_repo       = true
 	includes_all_repositories = true
 	permission                = "read"
-	units_map                 = {
-		"repo.code" = "read"
+	units                     = {
+		code = "read"
 	}
 }
 resource "forgejo_team_member" "test" {
@@ -64,8 +64,8 @@ resource "forgejo_team" "test" {
 	can_create_org_repo       = true
 	includes_all_repositories = true
 	permission                = "read"
-	units_map                 = {
-		"repo.code" = "read"
+	units                     = {
+		code = "read"
 	}
 }
 resource "forgejo_user" "test" {
@@ -99,8 +99,8 @@ resource "forgejo_team" "test" {
 	can_create_org_repo       = true
 	includes_all_repositories = true
 	permission                = "read"
-	units_map                 = {
-		"repo.code" = "read"
+	units                     = {
+		code = "read"
 	}
 }
 resource "forgejo_user" "test" {
@@ -139,8 +139,8 @@ resource "forgejo_team" "test" {
 	can_create_org_repo       = true
 	includes_all_repositories = true
 	permission                = "read"
-	units_map                 = {
-		"repo.code" = "read"
+	units                     = {
+		code = "read"
 	}
 }
 resource "forgejo_team" "test2" {
@@ -149,8 +149,8 @@ resource "forgejo_team" "test2" {
 	can_create_org_repo       = true
 	includes_all_repositories = true
 	permission                = "read"
-	units_map                 = {
-		"repo.code" = "read"
+	units                     = {
+		code = "read"
 	}
 }
 resource "forgejo_user" "test2" {
@@ -0,0 +1,402 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &teamMembersResource{}
+	_ resource.ResourceWithConfigure   = &teamMembersResource{}
+	_ resource.ResourceWithImportState = &teamMembersResource{}
+)
+
+// teamMembersResource is the resource implementation.
+type teamMembersResource struct {
+	client *forgejo.Client
+}
+
+// teamMembersResourceModel maps the resource schema data.
+type teamMembersResourceModel struct {
+	TeamID  types.Int64 `tfsdk:"team_id"`
+	Members types.Set   `tfsdk:"members"`
+}
+
+// from is a helper function to load API data into Terraform data model.
+// The spelling of user names already present in the model is preserved,
+// as Forgejo compares user names case-insensitively.
+func (m *teamMembersResourceModel) from(ctx context.Context, members []string) diag.Diagnostics {
+	var known []string
+	diags := m.Members.ElementsAs(ctx, &known, false)
+	if diags.HasError() {
+		return diags
+	}
+
+	names := make([]string, 0, len(members))
+	for _, member := range members {
+		idx := slices.IndexFunc(known, func(k string) bool {
+			return strings.EqualFold(k, member)
+		})
+		if idx != -1 {
+			member = known[idx]
+		}
+		names = append(names, member)
+	}
+
+	m.Members, diags = types.SetValueFrom(ctx, types.StringType, names)
+
+	return diags
+}
+
+// Metadata returns the resource type name.
+func (r *teamMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+// Schema defines the schema for the resource.
+func (r *teamMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo team members resource.
+
+This resource manages the complete set of team members authoritatively. Users not listed in ` + "`members`" + ` are removed from the team.
+
+**Note**: Do not combine this resource with ` + "`forgejo_team_member`" + ` resources for the same team, as they will conflict with each other!`,
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.Int64Attribute{
+				Description: "Numeric identifier of the team. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetAttribute{
+				Description: "Usernames of all team members.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *teamMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *teamMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer un(trace(ctx, "Create team members resource"))
+
+	var data teamMembersResourceModel
+
+	// Read Terraform plan data into model
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to set team members
+	diags = r.setTeamMembers(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *teamMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer un(trace(ctx, "Read team members resource"))
+
+	var data teamMembersResourceModel
+
+	// Read Terraform prior state into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to list team members
+	members, diags := listTeamMembers(
+		ctx,
+		r.client,
+		data.TeamID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	diags = data.from(ctx, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *teamMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer un(trace(ctx, "Update team members resource"))
+
+	var data teamMembersResourceModel
+
+	// Read Terraform plan data into model
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to set team members
+	diags = r.setTeamMembers(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *teamMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer un(trace(ctx, "Delete team members resource"))
+
+	var (
+		data    teamMembersResourceModel
+		members []string
+	)
+
+	// Read Terraform prior state into the model.
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = data.Members.ElementsAs(ctx, &members, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to delete team members
+	for _, member := range members {
+		diags = deleteTeamMember(
+			ctx,
+			r.client,
+			data.TeamID.ValueInt64(),
+			member,
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+// ImportState reads an existing resource and adds it to Terraform state on success.
+func (r *teamMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer un(trace(ctx, "Import team members resource"))
+
+	var state teamMembersResourceModel
+
+	// Parse import identifier
+	cmp := strings.Split(req.ID, "/")
+	if len(cmp) != 2 {
+		resp.Diagnostics.AddError(
+			"Unable to parse import identifier",
+			fmt.Sprintf(
+				"Expected import identifier with format: 'org/team', got: '%s'",
+				req.ID,
+			),
+		)
+
+		return
+	}
+	orgName, teamName := cmp[0], cmp[1]
+
+	// Use Forgejo client to get team
+	team, diags := getOrgTeamByName(
+		ctx,
+		r.client,
+		orgName,
+		teamName,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to list team members
+	members, diags := listTeamMembers(
+		ctx,
+		r.client,
+		team.ID,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	state.TeamID = types.Int64Value(team.ID)
+	state.Members = types.SetNull(types.StringType)
+	diags = state.from(ctx, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// setTeamMembers reconciles the members of a team with the Terraform data
+// model by adding missing and removing surplus members.
+func (r *teamMembersResource) setTeamMembers(ctx context.Context, data *teamMembersResourceModel) diag.Diagnostics {
+	var desired []string
+
+	diags := data.Members.ElementsAs(ctx, &desired, false)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Use Forgejo client to list current team members
+	current, diags := listTeamMembers(ctx, r.client, data.TeamID.ValueInt64())
+	if diags.HasError() {
+		return diags
+	}
+
+	// Add missing team members
+	for _, member := range desired {
+		if slices.ContainsFunc(current, func(c string) bool {
+			return strings.EqualFold(c, member)
+		}) {
+			continue
+		}
+
+		diags.Append(setTeamMember(ctx, r.client, data.TeamID.ValueInt64(), member)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// Remove surplus team members
+	for _, member := range current {
+		if slices.ContainsFunc(desired, func(d string) bool {
+			return strings.EqualFold(d, member)
+		}) {
+			continue
+		}
+
+		diags.Append(deleteTeamMember(ctx, r.client, data.TeamID.ValueInt64(), member)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// NewTeamMembersResource is a helper function to simplify the provider implementation.
+func NewTeamMembersResource() resource.Resource {
+	return &teamMembersResource{}
+}
+
+// listTeamMembers is a helper function to fetch the usernames of all team members.
+func listTeamMembers(ctx context.Context, client *forgejo.Client, teamID int64) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "List team members", map[string]any{
+		"team_id": teamID,
+	})
+
+	// Use Forgejo client to list team members
+	users, res, err := listAllPages(func(opts forgejo.ListOptions) ([]*forgejo.User, *forgejo.Response, error) {
+		return client.ListTeamMembers(
+			teamID,
+			forgejo.ListTeamMembersOptions{ListOptions: opts},
+		)
+	})
+	if err == nil {
+		members := make([]string, 0, len(users))
+		for _, u := range users {
+			members = append(members, u.UserName)
+		}
+
+		return members, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 404:
+			msg = fmt.Sprintf(
+				"Team with ID %d not found: %s",
+				teamID,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to list team members", msg)
+
+	return nil, diags
+}
@@ -0,0 +1,196 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTeamMembersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing (non-existent team)
+			{
+				Config: providerConfig + `
+resource "forgejo_team_members" "test" {
+	team_id = 1010
+	members = ["` + forgejoTestUser + `"]
+}`,
+				ExpectError: regexp.MustCompile("Team with ID 1010 not found"),
+			},
+			// Create and Read testing (non-existent user)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	name                      = "test_team"
+	organization_id           = forgejo_organization.test.id
+	includes_all_repositories = false
+	units                     = {
+		code = "read"
+	}
+}
+resource "forgejo_user" "test" {
+	login    = "test_user"
+	email    = "test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+resource "forgejo_user" "test2" {
+	login    = "second_test_user"
+	email    = "second_test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+resource "forgejo_team_members" "test" {
+	team_id = forgejo_team.test.id
+	members = ["non-existing-user"]
+}`,
+				ExpectError: regexp.MustCompile("Either user 'non-existing-user' or team with ID [0-9]+ not found"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	name                      = "test_team"
+	organization_id           = forgejo_organization.test.id
+	includes_all_repositories = false
+	units                     = {
+		code = "read"
+	}
+}
+resource "forgejo_user" "test" {
+	login    = "test_user"
+	email    = "test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+resource "forgejo_user" "test2" {
+	login    = "second_test_user"
+	email    = "second_test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+resource "forgejo_team_members" "test" {
+	team_id = forgejo_team.test.id
+	members = [forgejo_user.test.login, forgejo_user.test2.login]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_team_members.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs("forgejo_team_members.test", tfjsonpath.New("team_id"), "forgejo_team.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.ExpectKnownValue("forgejo_team_members.test", tfjsonpath.New("members"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("test_user"), knownvalue.StringExact("second_test_user")})),
+				},
+			},
+			// Import testing (invalid identifier)
+			{
+				ResourceName:  "forgejo_team_members.test",
+				ImportState:   true,
+				ImportStateId: "invalid",
+				ExpectError:   regexp.MustCompile("Expected import identifier with format: 'org/team', got: 'invalid'"),
+			},
+			// Import testing (non-existent team)
+			{
+				ResourceName:  "forgejo_team_members.test",
+				ImportState:   true,
+				ImportStateId: "test_org/non-existent",
+				ExpectError:   regexp.MustCompile("Team with name 'non-existent' not found"),
+			},
+			// Import testing
+			{
+				ResourceName:                         "forgejo_team_members.test",
+				ImportState:                          true,
+				ImportStateId:                        "test_org/test_team",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "team_id",
+			},
+			// Update and Read testing (remove member)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	name                      = "test_team"
+	organization_id           = forgejo_organization.test.id
+	includes_all_repositories = false
+	units                     = {
+		code = "read"
+	}
+}
+resource "forgejo_user" "test" {
+	login    = "test_user"
+	email    = "test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+resource "forgejo_user" "test2" {
+	login    = "second_test_user"
+	email    = "second_test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+resource "forgejo_team_members" "test" {
+	team_id = forgejo_team.test.id
+	members = [forgejo_user.test2.login]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_team_members.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_team_members.test", tfjsonpath.New("members"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("second_test_user")})),
+				},
+			},
+			// Update and Read testing (remove all members)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	name                      = "test_team"
+	organization_id           = forgejo_organization.test.id
+	includes_all_repositories = false
+	units                     = {
+		code = "read"
+	}
+}
+resource "forgejo_user" "test" {
+	login    = "test_user"
+	email    = "test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+resource "forgejo_user" "test2" {
+	login    = "second_test_user"
+	email    = "second_test_user@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+resource "forgejo_team_members" "test" {
+	team_id = forgejo_team.test.id
+	members = []
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_team_members.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_team_members.test", tfjsonpath.New("members"), knownvalue.SetSizeExact(0)),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
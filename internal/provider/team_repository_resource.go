@@ -0,0 +1,523 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &teamRepositoryResource{}
+	_ resource.ResourceWithConfigure   = &teamRepositoryResource{}
+	_ resource.ResourceWithImportState = &teamRepositoryResource{}
+)
+
+// teamRepositoryResource is the resource implementation.
+type teamRepositoryResource struct {
+	client *forgejo.Client
+}
+
+// teamRepositoryResourceModel maps the resource schema data.
+type teamRepositoryResourceModel struct {
+	TeamID       types.Int64  `tfsdk:"team_id"`
+	RepositoryID types.Int64  `tfsdk:"repository_id"`
+	Owner        types.String `tfsdk:"owner"`
+	Name         types.String `tfsdk:"name"`
+}
+
+// from is a helper function to load an API struct into Terraform data model.
+func (m *teamRepositoryResourceModel) from(r *forgejo.Repository) {
+	if r == nil {
+		return
+	}
+
+	m.RepositoryID = types.Int64Value(r.ID)
+	m.Name = types.StringValue(r.Name)
+	if r.Owner != nil {
+		m.Owner = types.StringValue(r.Owner.UserName)
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *teamRepositoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_repository"
+}
+
+// Schema defines the schema for the resource.
+func (r *teamRepositoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo team repository resource.
+
+**Note**: The repository must be owned by the organization of the team!`,
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.Int64Attribute{
+				Description: "Numeric identifier of the team. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"repository_id": schema.Int64Attribute{
+				MarkdownDescription: "Numeric identifier of the repository. Changing this forces a new resource to be created. **Note**: One of `repository_id` or `name` must be specified.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("name"),
+					}...),
+				},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "Owner of the repository. Changing this forces a new resource to be created. **Note**: Must be specified together with `name`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.Expressions{
+						path.MatchRoot("name"),
+					}...),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the repository. Changing this forces a new resource to be created. **Note**: One of `repository_id` or `name` must be specified.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("repository_id"),
+					}...),
+					stringvalidator.AlsoRequires(path.Expressions{
+						path.MatchRoot("owner"),
+					}...),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *teamRepositoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *teamRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer un(trace(ctx, "Create team repository resource"))
+
+	var (
+		data teamRepositoryResourceModel
+		rep  *forgejo.Repository
+	)
+
+	// Read Terraform plan data into model
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository
+	if data.RepositoryID.IsNull() || data.RepositoryID.IsUnknown() {
+		rep, diags = getRepositoryByName(
+			ctx,
+			r.client,
+			data.Owner.ValueString(),
+			data.Name.ValueString(),
+		)
+	} else {
+		rep, diags = getRepositoryByID(
+			ctx,
+			r.client,
+			data.RepositoryID.ValueInt64(),
+		)
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	data.from(rep)
+
+	// Use Forgejo client to add repository to team
+	diags = setTeamRepository(
+		ctx,
+		r.client,
+		data.TeamID.ValueInt64(),
+		data.Owner.ValueString(),
+		data.Name.ValueString(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *teamRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer un(trace(ctx, "Read team repository resource"))
+
+	var data teamRepositoryResourceModel
+
+	// Read Terraform prior state into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get team repository
+	rep, diags := getTeamRepository(
+		ctx,
+		r.client,
+		data.TeamID.ValueInt64(),
+		data.RepositoryID.ValueInt64(),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	data.from(rep)
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *teamRepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer un(trace(ctx, "Update team repository resource"))
+
+	/*
+	 * Team repositories can not be updated in-place. All writable attributes
+	 * have 'RequiresReplace' plan modifier set.
+	 */
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *teamRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer un(trace(ctx, "Delete team repository resource"))
+
+	var data teamRepositoryResourceModel
+
+	// Read Terraform prior state into the model.
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to remove repository from team
+	diags = deleteTeamRepository(
+		ctx,
+		r.client,
+		data.TeamID.ValueInt64(),
+		data.Owner.ValueString(),
+		data.Name.ValueString(),
+	)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ImportState reads an existing resource and adds it to Terraform state on success.
+func (r *teamRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer un(trace(ctx, "Import team repository resource"))
+
+	var state teamRepositoryResourceModel
+
+	// Parse import identifier
+	cmp := strings.Split(req.ID, "/")
+	if len(cmp) != 3 {
+		resp.Diagnostics.AddError(
+			"Unable to parse import identifier",
+			fmt.Sprintf(
+				"Expected import identifier with format: 'org/team/repo', got: '%s'",
+				req.ID,
+			),
+		)
+
+		return
+	}
+	orgName, teamName, repositoryName := cmp[0], cmp[1], cmp[2]
+
+	// Use Forgejo client to get team
+	team, diags := getOrgTeamByName(
+		ctx,
+		r.client,
+		orgName,
+		teamName,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get repository
+	rep, diags := getRepositoryByName(
+		ctx,
+		r.client,
+		orgName,
+		repositoryName,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get team repository
+	rep, diags = getTeamRepository(
+		ctx,
+		r.client,
+		team.ID,
+		rep.ID,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	state.TeamID = types.Int64Value(team.ID)
+	state.from(rep)
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewTeamRepositoryResource is a helper function to simplify the provider implementation.
+func NewTeamRepositoryResource() resource.Resource {
+	return &teamRepositoryResource{}
+}
+
+// getTeamRepository is a helper function to fetch a repository assigned to a team.
+func getTeamRepository(ctx context.Context, client *forgejo.Client, teamID, repositoryID int64) (*forgejo.Repository, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "List team repositories", map[string]any{
+		"team_id":       teamID,
+		"repository_id": repositoryID,
+	})
+
+	// Use Forgejo client to list team repositories
+	reps, res, err := listAllPages(func(opts forgejo.ListOptions) ([]*forgejo.Repository, *forgejo.Response, error) {
+		return client.ListTeamRepositories(
+			teamID,
+			forgejo.ListTeamRepositoriesOptions{ListOptions: opts},
+		)
+	})
+	if err != nil {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			tflog.Error(ctx, "Error", map[string]any{
+				"status": res.Status,
+			})
+
+			switch res.StatusCode {
+			case 404:
+				msg = fmt.Sprintf(
+					"Team with ID %d not found: %s",
+					teamID,
+					err,
+				)
+			default:
+				msg = fmt.Sprintf(
+					"Unknown error (status %d): %s",
+					res.StatusCode,
+					err,
+				)
+			}
+		}
+		diags.AddError("Unable to list team repositories", msg)
+
+		return nil, diags
+	}
+
+	// Search for repository with given ID
+	idx := slices.IndexFunc(reps, func(r *forgejo.Repository) bool {
+		return r.ID == repositoryID
+	})
+	if idx == -1 {
+		diags.AddError(
+			"Unable to read team repository",
+			fmt.Sprintf(
+				"Repository with ID %d in team with ID %d not found",
+				repositoryID,
+				teamID,
+			),
+		)
+
+		return nil, diags
+	}
+
+	return reps[idx], diags
+}
+
+// setTeamRepository is a helper function to add a repository to a team.
+func setTeamRepository(ctx context.Context, client *forgejo.Client, teamID int64, owner, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Create team repository", map[string]any{
+		"team_id": teamID,
+		"owner":   owner,
+		"name":    name,
+	})
+
+	// Use Forgejo client to add repository to team
+	res, err := client.AddTeamRepository(teamID, owner, name)
+	if err == nil {
+		return diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 403:
+			msg = fmt.Sprintf(
+				"Adding repository '%s/%s' to team with ID %d forbidden: %s",
+				owner,
+				name,
+				teamID,
+				err,
+			)
+		case 404:
+			msg = fmt.Sprintf(
+				"Either repository '%s/%s' or team with ID %d not found: %s",
+				owner,
+				name,
+				teamID,
+				err,
+			)
+		case 422:
+			msg = fmt.Sprintf(
+				"Repository '%s/%s' is not owned by the organization of team with ID %d: %s",
+				owner,
+				name,
+				teamID,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to create team repository", msg)
+
+	return diags
+}
+
+// deleteTeamRepository is a helper function to remove a repository from a team.
+func deleteTeamRepository(ctx context.Context, client *forgejo.Client, teamID int64, owner, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Delete team repository", map[string]any{
+		"team_id": teamID,
+		"owner":   owner,
+		"name":    name,
+	})
+
+	// Use Forgejo client to remove repository from team
+	res, err := client.RemoveTeamRepository(teamID, owner, name)
+	if err == nil {
+		return diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 403:
+			msg = fmt.Sprintf(
+				"Removing repository '%s/%s' from team with ID %d forbidden: %s",
+				owner,
+				name,
+				teamID,
+				err,
+			)
+		case 404:
+			msg = fmt.Sprintf(
+				"Either repository '%s/%s' or team with ID %d not found: %s",
+				owner,
+				name,
+				teamID,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to delete team repository", msg)
+
+	return diags
+}
@@ -0,0 +1,177 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTeamRepositoryResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing (neither repository ID nor name)
+			{
+				Config: providerConfig + `
+resource "forgejo_team_repository" "test" {
+	team_id = 1010
+}`,
+				ExpectError: regexp.MustCompile("No attribute specified when one \\(and only one\\) of"),
+			},
+			// Validation testing (name without owner)
+			{
+				Config: providerConfig + `
+resource "forgejo_team_repository" "test" {
+	team_id = 1010
+	name    = "test_repo"
+}`,
+				ExpectError: regexp.MustCompile(`Attribute "owner" must be specified when "name" is`),
+			},
+			// Create and Read testing (non-existent team)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_repository" "test" {
+	owner = forgejo_organization.test.name
+	name  = "test_repo"
+}
+resource "forgejo_team_repository" "test" {
+	team_id       = 1010
+	repository_id = forgejo_repository.test.id
+}`,
+				ExpectError: regexp.MustCompile("Either repository 'test_org/test_repo' or team with ID 1010 not found"),
+			},
+			// Create and Read testing (non-existent repository)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	name                      = "test_team"
+	organization_id           = forgejo_organization.test.id
+	includes_all_repositories = false
+	units                     = {
+		code = "read"
+	}
+}
+resource "forgejo_team_repository" "test" {
+	team_id = forgejo_team.test.id
+	owner   = forgejo_organization.test.name
+	name    = "non_existent"
+}`,
+				ExpectError: regexp.MustCompile("Repository with owner 'test_org' and name 'non_existent' not found"),
+			},
+			// Create and Read testing (by repository ID)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	name                      = "test_team"
+	organization_id           = forgejo_organization.test.id
+	includes_all_repositories = false
+	units                     = {
+		code = "read"
+	}
+}
+resource "forgejo_repository" "test" {
+	owner = forgejo_organization.test.name
+	name  = "test_repo"
+}
+resource "forgejo_team_repository" "test" {
+	team_id       = forgejo_team.test.id
+	repository_id = forgejo_repository.test.id
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_team_repository.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs("forgejo_team_repository.test", tfjsonpath.New("team_id"), "forgejo_team.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.CompareValuePairs("forgejo_team_repository.test", tfjsonpath.New("repository_id"), "forgejo_repository.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.CompareValuePairs("forgejo_team_repository.test", tfjsonpath.New("owner"), "forgejo_organization.test", tfjsonpath.New("name"), compare.ValuesSame()),
+					statecheck.CompareValuePairs("forgejo_team_repository.test", tfjsonpath.New("name"), "forgejo_repository.test", tfjsonpath.New("name"), compare.ValuesSame()),
+				},
+			},
+			// Import testing (invalid identifier)
+			{
+				ResourceName:  "forgejo_team_repository.test",
+				ImportState:   true,
+				ImportStateId: "invalid",
+				ExpectError:   regexp.MustCompile("Expected import identifier with format: 'org/team/repo', got: 'invalid'"),
+			},
+			// Import testing (non-existent team)
+			{
+				ResourceName:  "forgejo_team_repository.test",
+				ImportState:   true,
+				ImportStateId: "test_org/non-existent/test_repo",
+				ExpectError:   regexp.MustCompile("Team with name 'non-existent' not found"),
+			},
+			// Import testing (non-existent repository)
+			{
+				ResourceName:  "forgejo_team_repository.test",
+				ImportState:   true,
+				ImportStateId: "test_org/test_team/non-existent",
+				ExpectError:   regexp.MustCompile("Repository with owner 'test_org' and name 'non-existent' not found"),
+			},
+			// Import testing
+			{
+				ResourceName:                         "forgejo_team_repository.test",
+				ImportState:                          true,
+				ImportStateId:                        "test_org/test_team/test_repo",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "repository_id",
+			},
+			// Recreate and Read testing (by owner and name)
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	name                      = "test_team"
+	organization_id           = forgejo_organization.test.id
+	includes_all_repositories = false
+	units                     = {
+		code = "read"
+	}
+}
+resource "forgejo_repository" "test" {
+	owner = forgejo_organization.test.name
+	name  = "test_repo"
+}
+resource "forgejo_repository" "test2" {
+	owner = forgejo_organization.test.name
+	name  = "second_test_repo"
+}
+resource "forgejo_team_repository" "test" {
+	team_id = forgejo_team.test.id
+	owner   = forgejo_repository.test2.owner
+	name    = forgejo_repository.test2.name
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_team_repository.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs("forgejo_team_repository.test", tfjsonpath.New("team_id"), "forgejo_team.test", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.CompareValuePairs("forgejo_team_repository.test", tfjsonpath.New("repository_id"), "forgejo_repository.test2", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.CompareValuePairs("forgejo_team_repository.test", tfjsonpath.New("name"), "forgejo_repository.test2", tfjsonpath.New("name"), compare.ValuesSame()),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
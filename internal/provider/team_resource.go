@@ -6,8 +6,8 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
-	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
@@ -26,11 +27,51 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &teamResource{}
-	_ resource.ResourceWithConfigure   = &teamResource{}
-	_ resource.ResourceWithImportState = &teamResource{}
+	_ resource.Resource                 = &teamResource{}
+	_ resource.ResourceWithConfigure    = &teamResource{}
+	_ resource.ResourceWithImportState  = &teamResource{}
+	_ resource.ResourceWithUpgradeState = &teamResource{}
 )
 
+// teamUnitValues are the supported access levels of team units. Forgejo
+// reports 'owner' for all units of the owners team.
+var teamUnitValues = []string{
+	"none",
+	"read",
+	"write",
+	"admin",
+	"owner",
+}
+
+// teamUnits are the repository units of teams, keyed by attribute name
+// (without the 'repo.' prefix of the API) with a description of each unit.
+var teamUnits = []struct {
+	name        string
+	description string
+}{
+	{"code", "code"},
+	{"issues", "issues"},
+	{"pulls", "pull requests"},
+	{"releases", "releases"},
+	{"wiki", "wiki"},
+	{"ext_wiki", "external wiki"},
+	{"ext_issues", "external issue tracker"},
+	{"projects", "projects"},
+	{"packages", "packages"},
+	{"actions", "actions"},
+}
+
+// teamUnitValuesDescription lists the supported access levels of team units
+// for schema descriptions, e.g. "'none', 'read' or 'write'".
+func teamUnitValuesDescription() string {
+	values := make([]string, len(teamUnitValues))
+	for i, v := range teamUnitValues {
+		values[i] = "'" + v + "'"
+	}
+
+	return strings.Join(values[:len(values)-1], ", ") + " or " + values[len(values)-1]
+}
+
 // teamResource is the resource implementation.
 type teamResource struct {
 	client *forgejo.Client
@@ -38,6 +79,89 @@ type teamResource struct {
 
 // teamResourceModel maps the resource schema data.
 type teamResourceModel struct {
+	ID                      types.Int64  `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Organization            types.String `tfsdk:"organization"`
+	OrganizationID          types.Int64  `tfsdk:"organization_id"`
+	CanCreateOrgRepo        types.Bool   `tfsdk:"can_create_org_repo"`
+	Description             types.String `tfsdk:"description"`
+	IncludesAllRepositories types.Bool   `tfsdk:"includes_all_repositories"`
+	Permission              types.String `tfsdk:"permission"`
+	Units                   types.Object `tfsdk:"units"`
+}
+
+// teamResourceUnits maps the access levels of the team units.
+type teamResourceUnits struct {
+	Code      types.String `tfsdk:"code"`
+	Issues    types.String `tfsdk:"issues"`
+	Pulls     types.String `tfsdk:"pulls"`
+	Releases  types.String `tfsdk:"releases"`
+	Wiki      types.String `tfsdk:"wiki"`
+	ExtWiki   types.String `tfsdk:"ext_wiki"`
+	ExtIssues types.String `tfsdk:"ext_issues"`
+	Projects  types.String `tfsdk:"projects"`
+	Packages  types.String `tfsdk:"packages"`
+	Actions   types.String `tfsdk:"actions"`
+}
+
+func (m teamResourceUnits) attributeTypes() map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type, len(teamUnits))
+	for _, u := range teamUnits {
+		attrTypes[u.name] = types.StringType
+	}
+
+	return attrTypes
+}
+
+// from is a helper function to load an API units map into Terraform data model.
+// Units missing from the map have no access.
+func (m *teamResourceUnits) from(units map[string]string) {
+	unit := func(name string) types.String {
+		if v, ok := units["repo."+name]; ok {
+			return types.StringValue(v)
+		}
+
+		return types.StringValue("none")
+	}
+
+	m.Code = unit("code")
+	m.Issues = unit("issues")
+	m.Pulls = unit("pulls")
+	m.Releases = unit("releases")
+	m.Wiki = unit("wiki")
+	m.ExtWiki = unit("ext_wiki")
+	m.ExtIssues = unit("ext_issues")
+	m.Projects = unit("projects")
+	m.Packages = unit("packages")
+	m.Actions = unit("actions")
+}
+
+// to is a helper function to save Terraform data model into an API units map.
+// Units without access are omitted, as Forgejo revokes access to missing units.
+func (m *teamResourceUnits) to() map[string]string {
+	units := map[string]string{}
+	unit := func(name string, v types.String) {
+		if v.ValueString() != "none" {
+			units["repo."+name] = v.ValueString()
+		}
+	}
+
+	unit("code", m.Code)
+	unit("issues", m.Issues)
+	unit("pulls", m.Pulls)
+	unit("releases", m.Releases)
+	unit("wiki", m.Wiki)
+	unit("ext_wiki", m.ExtWiki)
+	unit("ext_issues", m.ExtIssues)
+	unit("projects", m.Projects)
+	unit("packages", m.Packages)
+	unit("actions", m.Actions)
+
+	return units
+}
+
+// teamResourceModelV0 maps the resource schema data of schema version 0.
+type teamResourceModelV0 struct {
 	ID                      types.Int64  `tfsdk:"id"`
 	Name                    types.String `tfsdk:"name"`
 	Organization            types.String `tfsdk:"organization"`
@@ -61,7 +185,10 @@ func (m *teamResourceModel) from(t *forgejo.Team, ctx context.Context) (diags di
 	m.Permission = types.StringValue(string(t.Permission))
 	m.CanCreateOrgRepo = types.BoolValue(t.CanCreateOrgRepo)
 	m.IncludesAllRepositories = types.BoolValue(t.IncludesAllRepositories)
-	m.UnitsMap, diags = types.MapValueFrom(ctx, types.StringType, t.UnitsMap)
+
+	var units teamResourceUnits
+	units.from(t.UnitsMap)
+	m.Units, diags = types.ObjectValueFrom(ctx, units.attributeTypes(), units)
 
 	return diags
 }
@@ -77,7 +204,13 @@ func (m *teamResourceModel) to(o *forgejo.EditTeamOption, ctx context.Context) (
 	o.Permission = forgejo.AccessMode(m.Permission.ValueString())
 	o.CanCreateOrgRepo = m.CanCreateOrgRepo.ValueBoolPointer()
 	o.IncludesAllRepositories = m.IncludesAllRepositories.ValueBoolPointer()
-	diags = m.UnitsMap.ElementsAs(ctx, &o.UnitsMap, false)
+
+	var units teamResourceUnits
+	diags = m.Units.As(ctx, &units, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+	o.UnitsMap = units.to()
 
 	return diags
 }
@@ -89,7 +222,22 @@ func (r *teamResource) Metadata(_ context.Context, req resource.MetadataRequest,
 
 // Schema defines the schema for the resource.
 func (r *teamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	units := make(map[string]schema.Attribute, len(teamUnits))
+	for _, u := range teamUnits {
+		units[u.name] = schema.StringAttribute{
+			Description: "Access level to " + u.description + ". Defaults to 'none'.",
+			Computed:    true,
+			Optional:    true,
+			Default:     stringdefault.StaticString("none"),
+			Validators: []validator.String{
+				stringvalidator.OneOf(teamUnitValues...),
+			},
+		}
+	}
+
 	resp.Schema = schema.Schema{
+		Version: 1,
+
 		MarkdownDescription: `Forgejo team resource.
 
 **Note**: The authenticated user must be a member of the managed organization(s) or have administrative privileges!`,
@@ -155,7 +303,7 @@ func (r *teamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"permission": schema.StringAttribute{
-				Description: "Permissions within the owning organization. **Note**: If you set `admin` or `owner` here, make sure to set the correct `units_map`.",
+				Description: "Permissions within the owning organization. **Note**: If you set `admin` or `owner` here, make sure to set the correct `units`.",
 				Computed:    true,
 				Optional:    true,
 				Default:     stringdefault.StaticString("read"),
@@ -168,35 +316,10 @@ func (r *teamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					),
 				},
 			},
-			"units_map": schema.MapAttribute{
-				Description: "Map of access units. **Note**: If the `permission` is `admin` or `owner` all units must be set to `admin` as well.",
-				ElementType: types.StringType,
-				Required:    true,
-				Validators: []validator.Map{
-					mapvalidator.KeysAre(
-						stringvalidator.OneOf(
-							"repo.code",
-							"repo.issues",
-							"repo.pulls",
-							"repo.ext_issues",
-							"repo.wiki",
-							"repo.ext_wiki",
-							"repo.releases",
-							"repo.projects",
-							"repo.packages",
-							"repo.actions",
-						),
-					),
-					mapvalidator.ValueStringsAre(
-						stringvalidator.OneOf(
-							"none",
-							"read",
-							"write",
-							"owner",
-							"admin",
-						),
-					),
-				},
+			"units": schema.SingleNestedAttribute{
+				Attributes:          units,
+				MarkdownDescription: "Access levels to the repository units (" + teamUnitValuesDescription() + "). **Note**: If the `permission` is `admin` or `owner` all units must be set to the same access level as well.",
+				Required:            true,
 			},
 		},
 	}
@@ -502,6 +625,77 @@ func (r *teamResource) ImportState(ctx context.Context, req resource.ImportState
 	resp.Diagnostics.Append(diags...)
 }
 
+// UpgradeState upgrades the resource state from prior schema versions.
+func (r *teamResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored the unit access levels in the 'units_map' attribute
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                        schema.Int64Attribute{Computed: true},
+					"name":                      schema.StringAttribute{Required: true},
+					"organization":              schema.StringAttribute{Computed: true, Optional: true},
+					"organization_id":           schema.Int64Attribute{Computed: true, Optional: true},
+					"can_create_org_repo":       schema.BoolAttribute{Computed: true, Optional: true},
+					"description":               schema.StringAttribute{Computed: true, Optional: true},
+					"includes_all_repositories": schema.BoolAttribute{Computed: true, Optional: true},
+					"permission":                schema.StringAttribute{Computed: true, Optional: true},
+					"units_map": schema.MapAttribute{
+						ElementType: types.StringType,
+						Required:    true,
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				defer un(trace(ctx, "Upgrade team resource state from version 0"))
+
+				var (
+					prior    teamResourceModelV0
+					unitsMap map[string]string
+					units    teamResourceUnits
+				)
+
+				// Read Terraform prior state data into the model
+				diags := req.State.Get(ctx, &prior)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				diags = prior.UnitsMap.ElementsAs(ctx, &unitsMap, false)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// Map units map to typed units object
+				units.from(unitsMap)
+				unitsValue, diags := types.ObjectValueFrom(ctx, units.attributeTypes(), units)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := teamResourceModel{
+					ID:                      prior.ID,
+					Name:                    prior.Name,
+					Organization:            prior.Organization,
+					OrganizationID:          prior.OrganizationID,
+					CanCreateOrgRepo:        prior.CanCreateOrgRepo,
+					Description:             prior.Description,
+					IncludesAllRepositories: prior.IncludesAllRepositories,
+					Permission:              prior.Permission,
+					Units:                   unitsValue,
+				}
+
+				// Save data into Terraform state
+				diags = resp.State.Set(ctx, &upgraded)
+				resp.Diagnostics.Append(diags...)
+			},
+		},
+	}
+}
+
 // NewTeamResource is a helper function to simplify the provider implementation.
 func NewTeamResource() resource.Resource {
 	return &teamResource{}
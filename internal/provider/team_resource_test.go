@@ -1,15 +1,23 @@
 package provider_test
 
 import (
+	"context"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"terraform-provider-forgejo/internal/provider"
 )
 
 func TestAccTeamResource(t *testing.T) {
@@ -24,8 +32,8 @@ resource "forgejo_team" "test" {
 	name            = "tftest"
 	organization_id = 1011
 
-	units_map = {
-		"repo.issues" = "read"
+	units = {
+		issues = "read"
 	}
 }`,
 				ExpectError: regexp.MustCompile("Organization with ID 1011 not found"),
@@ -37,12 +45,25 @@ resource "forgejo_team" "test" {
 	name         = "tftest"
 	organization = "non-existent"
 
-	units_map = {
-		"repo.issues" = "read"
+	units = {
+		issues = "read"
 	}
 }`,
 				ExpectError: regexp.MustCompile("Organization with name 'non-existent' not found"),
 			},
+			// Invalid unit access level
+			{
+				Config: providerConfig + `
+resource "forgejo_team" "test" {
+	name         = "tftest"
+	organization = "non-existent"
+
+	units = {
+		issues = "owner"
+	}
+}`,
+				ExpectError: regexp.MustCompile("value must be one of"),
+			},
 			// Create and Read testing
 			{
 				Config: providerConfig + `
@@ -57,8 +78,8 @@ resource "forgejo_team" "test_by_id" {
 	includes_all_repositories = false
 	permission                = "read"
 
-	units_map = {
-		"repo.issues" = "read"
+	units = {
+		issues = "read"
 	}
 }
 resource "forgejo_team" "test_by_name" {
@@ -69,8 +90,8 @@ resource "forgejo_team" "test_by_name" {
 	includes_all_repositories = false
 	permission                = "read"
 
-	units_map = {
-		"repo.issues" = "read"
+	units = {
+		issues = "read"
 	}
 }`,
 				ConfigPlanChecks: resource.ConfigPlanChecks{
@@ -88,8 +109,8 @@ resource "forgejo_team" "test_by_name" {
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("description"), knownvalue.StringExact("Test team.")),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("includes_all_repositories"), knownvalue.Bool(false)),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("permission"), knownvalue.StringExact("read")),
-					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("units_map"), knownvalue.MapExact(map[string]knownvalue.Check{
-						"repo.issues": knownvalue.StringExact("read"),
+					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("units"), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"issues": knownvalue.StringExact("read"),
 					})),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("id"), knownvalue.NotNull()),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("name"), knownvalue.StringExact("test_team_by_name")),
@@ -99,8 +120,8 @@ resource "forgejo_team" "test_by_name" {
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("description"), knownvalue.StringExact("Test team.")),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("includes_all_repositories"), knownvalue.Bool(false)),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("permission"), knownvalue.StringExact("read")),
-					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("units_map"), knownvalue.MapExact(map[string]knownvalue.Check{
-						"repo.issues": knownvalue.StringExact("read"),
+					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("units"), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"issues": knownvalue.StringExact("read"),
 					})),
 				},
 			},
@@ -118,8 +139,8 @@ resource "forgejo_team" "test_by_id" {
 	includes_all_repositories = false
 	permission                = "read"
 
-	units_map = {
-		"repo.issues" = "read"
+	units = {
+		issues = "read"
 	}
 }
 resource "forgejo_team" "test_by_id2" {
@@ -128,8 +149,8 @@ resource "forgejo_team" "test_by_id2" {
 	organization_id = forgejo_organization.test.id
 	permission      = "write"
 
-	units_map = {
-		"repo.issues" = "read"
+	units = {
+		issues = "read"
 	}
 }
 resource "forgejo_team" "test_by_name" {
@@ -140,8 +161,8 @@ resource "forgejo_team" "test_by_name" {
 	includes_all_repositories = false
 	permission                = "read"
 
-	units_map = {
-		"repo.issues" = "read"
+	units = {
+		issues = "read"
 	}
 }
 resource "forgejo_team" "test_by_name2" {
@@ -150,8 +171,8 @@ resource "forgejo_team" "test_by_name2" {
 	organization = forgejo_organization.test.name
 	permission   = "write"
 
-	units_map = {
-		"repo.issues" = "read"
+	units = {
+		issues = "read"
 	}
 }`,
 				ExpectError: regexp.MustCompile("Input validation error: team already exists"),
@@ -206,8 +227,8 @@ resource "forgejo_team" "test_by_id" {
 	includes_all_repositories = true
 	permission                = "write"
 
-	units_map = {
-		"repo.issues" = "write"
+	units = {
+		issues = "write"
 	}
 }
 resource "forgejo_team" "test_by_name" {
@@ -218,8 +239,8 @@ resource "forgejo_team" "test_by_name" {
 	includes_all_repositories = true
 	permission                = "write"
 
-	units_map = {
-		"repo.issues" = "write"
+	units = {
+		issues = "write"
 	}
 }`,
 				ConfigPlanChecks: resource.ConfigPlanChecks{
@@ -237,8 +258,8 @@ resource "forgejo_team" "test_by_name" {
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("description"), knownvalue.StringExact("Updated test team.")),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("includes_all_repositories"), knownvalue.Bool(true)),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("permission"), knownvalue.StringExact("write")),
-					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("units_map"), knownvalue.MapExact(map[string]knownvalue.Check{
-						"repo.issues": knownvalue.StringExact("write"),
+					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("units"), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"issues": knownvalue.StringExact("write"),
 					})),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("id"), knownvalue.NotNull()),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("name"), knownvalue.StringExact("test_team_by_name")),
@@ -248,8 +269,8 @@ resource "forgejo_team" "test_by_name" {
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("description"), knownvalue.StringExact("Updated test team.")),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("includes_all_repositories"), knownvalue.Bool(true)),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("permission"), knownvalue.StringExact("write")),
-					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("units_map"), knownvalue.MapExact(map[string]knownvalue.Check{
-						"repo.issues": knownvalue.StringExact("write"),
+					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("units"), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"issues": knownvalue.StringExact("write"),
 					})),
 				},
 			},
@@ -265,8 +286,8 @@ resource "forgejo_team" "test_by_id" {
 	description     = "Updated test team."
 	permission      = "write"
 
-	units_map = {
-		"repo.issues" = "write"
+	units = {
+		issues = "write"
 	}
 }
 resource "forgejo_team" "test_by_name" {
@@ -275,8 +296,8 @@ resource "forgejo_team" "test_by_name" {
 	description  = "Updated test team."
 	permission   = "write"
 
-	units_map = {
-		"repo.issues" = "write"
+	units = {
+		issues = "write"
 	}
 }`,
 				ConfigPlanChecks: resource.ConfigPlanChecks{
@@ -294,8 +315,8 @@ resource "forgejo_team" "test_by_name" {
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("description"), knownvalue.StringExact("Updated test team.")),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("includes_all_repositories"), knownvalue.Bool(true)),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("permission"), knownvalue.StringExact("write")),
-					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("units_map"), knownvalue.MapExact(map[string]knownvalue.Check{
-						"repo.issues": knownvalue.StringExact("write"),
+					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("units"), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"issues": knownvalue.StringExact("write"),
 					})),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("id"), knownvalue.NotNull()),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("name"), knownvalue.StringExact("renamed_test_team_by_name")),
@@ -305,8 +326,8 @@ resource "forgejo_team" "test_by_name" {
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("description"), knownvalue.StringExact("Updated test team.")),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("includes_all_repositories"), knownvalue.Bool(true)),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("permission"), knownvalue.StringExact("write")),
-					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("units_map"), knownvalue.MapExact(map[string]knownvalue.Check{
-						"repo.issues": knownvalue.StringExact("write"),
+					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("units"), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"issues": knownvalue.StringExact("write"),
 					})),
 				},
 			},
@@ -324,8 +345,8 @@ resource "forgejo_team" "test_by_id" {
 	organization_id = forgejo_organization.new_test.id
 	permission      = "write"
 
-	units_map = {
-		"repo.issues" = "write"
+	units = {
+		issues = "write"
 	}
 }
 resource "forgejo_team" "test_by_name" {
@@ -333,8 +354,8 @@ resource "forgejo_team" "test_by_name" {
 	organization = forgejo_organization.new_test.name
 	permission   = "write"
 
-	units_map = {
-		"repo.issues" = "write"
+	units = {
+		issues = "write"
 	}
 }`,
 				ConfigPlanChecks: resource.ConfigPlanChecks{
@@ -352,8 +373,8 @@ resource "forgejo_team" "test_by_name" {
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("description"), knownvalue.StringExact("")),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("includes_all_repositories"), knownvalue.Bool(false)),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("permission"), knownvalue.StringExact("write")),
-					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("units_map"), knownvalue.MapExact(map[string]knownvalue.Check{
-						"repo.issues": knownvalue.StringExact("write"),
+					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("units"), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"issues": knownvalue.StringExact("write"),
 					})),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("id"), knownvalue.NotNull()),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("name"), knownvalue.StringExact("renamed_test_team_by_name")),
@@ -363,8 +384,8 @@ resource "forgejo_team" "test_by_name" {
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("description"), knownvalue.StringExact("")),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("includes_all_repositories"), knownvalue.Bool(false)),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("permission"), knownvalue.StringExact("write")),
-					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("units_map"), knownvalue.MapExact(map[string]knownvalue.Check{
-						"repo.issues": knownvalue.StringExact("write"),
+					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("units"), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"issues": knownvalue.StringExact("write"),
 					})),
 				},
 			},
@@ -379,17 +400,17 @@ resource "forgejo_team" "test_by_id" {
 	organization_id = forgejo_organization.new_test.id
 	permission      = "admin"
 
-	units_map = {
-		"repo.code"       = "admin"
-		"repo.issues"     = "admin"
-		"repo.pulls"      = "admin"
-		"repo.ext_issues" = "admin"
-		"repo.wiki"       = "admin"
-		"repo.ext_wiki"   = "admin"
-		"repo.releases"   = "admin"
-		"repo.projects"   = "admin"
-		"repo.packages"   = "admin"
-		"repo.actions"    = "admin"
+	units = {
+		code       = "admin"
+		issues     = "admin"
+		pulls      = "admin"
+		ext_issues = "admin"
+		wiki       = "admin"
+		ext_wiki   = "admin"
+		releases   = "admin"
+		projects   = "admin"
+		packages   = "admin"
+		actions    = "admin"
 	}
 }
 resource "forgejo_team" "test_by_name" {
@@ -397,17 +418,17 @@ resource "forgejo_team" "test_by_name" {
 	organization = forgejo_organization.new_test.name
 	permission   = "admin"
 
-	units_map = {
-		"repo.code"       = "admin"
-		"repo.issues"     = "admin"
-		"repo.pulls"      = "admin"
-		"repo.ext_issues" = "admin"
-		"repo.wiki"       = "admin"
-		"repo.ext_wiki"   = "admin"
-		"repo.releases"   = "admin"
-		"repo.projects"   = "admin"
-		"repo.packages"   = "admin"
-		"repo.actions"    = "admin"
+	units = {
+		code       = "admin"
+		issues     = "admin"
+		pulls      = "admin"
+		ext_issues = "admin"
+		wiki       = "admin"
+		ext_wiki   = "admin"
+		releases   = "admin"
+		projects   = "admin"
+		packages   = "admin"
+		actions    = "admin"
 	}
 }`,
 				ConfigPlanChecks: resource.ConfigPlanChecks{
@@ -425,17 +446,17 @@ resource "forgejo_team" "test_by_name" {
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("description"), knownvalue.StringExact("")),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("includes_all_repositories"), knownvalue.Bool(false)),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("permission"), knownvalue.StringExact("admin")),
-					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("units_map"), knownvalue.MapExact(map[string]knownvalue.Check{
-						"repo.code":       knownvalue.StringExact("admin"),
-						"repo.issues":     knownvalue.StringExact("admin"),
-						"repo.pulls":      knownvalue.StringExact("admin"),
-						"repo.ext_issues": knownvalue.StringExact("admin"),
-						"repo.wiki":       knownvalue.StringExact("admin"),
-						"repo.ext_wiki":   knownvalue.StringExact("admin"),
-						"repo.releases":   knownvalue.StringExact("admin"),
-						"repo.projects":   knownvalue.StringExact("admin"),
-						"repo.packages":   knownvalue.StringExact("admin"),
-						"repo.actions":    knownvalue.StringExact("admin"),
+					statecheck.ExpectKnownValue("forgejo_team.test_by_id", tfjsonpath.New("units"), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"code":       knownvalue.StringExact("admin"),
+						"issues":     knownvalue.StringExact("admin"),
+						"pulls":      knownvalue.StringExact("admin"),
+						"ext_issues": knownvalue.StringExact("admin"),
+						"wiki":       knownvalue.StringExact("admin"),
+						"ext_wiki":   knownvalue.StringExact("admin"),
+						"releases":   knownvalue.StringExact("admin"),
+						"projects":   knownvalue.StringExact("admin"),
+						"packages":   knownvalue.StringExact("admin"),
+						"actions":    knownvalue.StringExact("admin"),
 					})),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("id"), knownvalue.NotNull()),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("name"), knownvalue.StringExact("renamed_test_team_by_name")),
@@ -445,17 +466,17 @@ resource "forgejo_team" "test_by_name" {
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("description"), knownvalue.StringExact("")),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("includes_all_repositories"), knownvalue.Bool(false)),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("permission"), knownvalue.StringExact("admin")),
-					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("units_map"), knownvalue.MapExact(map[string]knownvalue.Check{
-						"repo.code":       knownvalue.StringExact("admin"),
-						"repo.issues":     knownvalue.StringExact("admin"),
-						"repo.pulls":      knownvalue.StringExact("admin"),
-						"repo.ext_issues": knownvalue.StringExact("admin"),
-						"repo.wiki":       knownvalue.StringExact("admin"),
-						"repo.ext_wiki":   knownvalue.StringExact("admin"),
-						"repo.releases":   knownvalue.StringExact("admin"),
-						"repo.projects":   knownvalue.StringExact("admin"),
-						"repo.packages":   knownvalue.StringExact("admin"),
-						"repo.actions":    knownvalue.StringExact("admin"),
+					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("units"), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"code":       knownvalue.StringExact("admin"),
+						"issues":     knownvalue.StringExact("admin"),
+						"pulls":      knownvalue.StringExact("admin"),
+						"ext_issues": knownvalue.StringExact("admin"),
+						"wiki":       knownvalue.StringExact("admin"),
+						"ext_wiki":   knownvalue.StringExact("admin"),
+						"releases":   knownvalue.StringExact("admin"),
+						"projects":   knownvalue.StringExact("admin"),
+						"packages":   knownvalue.StringExact("admin"),
+						"actions":    knownvalue.StringExact("admin"),
 					})),
 				},
 			},
@@ -471,8 +492,8 @@ resource "forgejo_team" "test_by_id" {
 	organization_id = forgejo_organization.test.id
 	name            = "test_team_${count.index}_by_id"
 
-	units_map = {
-		"repo.code" = "read"
+	units = {
+		code = "read"
 	}
 }
 resource "forgejo_team" "test_by_name" {
@@ -481,8 +502,8 @@ resource "forgejo_team" "test_by_name" {
 	organization = forgejo_organization.test.name
 	name         = "test_team_${count.index}_by_name"
 
-	units_map = {
-		"repo.code" = "read"
+	units = {
+		code = "read"
 	}
 }`,
 				ConfigPlanChecks: resource.ConfigPlanChecks{
@@ -500,8 +521,8 @@ resource "forgejo_team" "test_by_name" {
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id[99]", tfjsonpath.New("description"), knownvalue.StringExact("")),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id[99]", tfjsonpath.New("includes_all_repositories"), knownvalue.Bool(false)),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_id[99]", tfjsonpath.New("permission"), knownvalue.StringExact("read")),
-					statecheck.ExpectKnownValue("forgejo_team.test_by_id[99]", tfjsonpath.New("units_map"), knownvalue.MapExact(map[string]knownvalue.Check{
-						"repo.code": knownvalue.StringExact("read"),
+					statecheck.ExpectKnownValue("forgejo_team.test_by_id[99]", tfjsonpath.New("units"), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"code": knownvalue.StringExact("read"),
 					})),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name[99]", tfjsonpath.New("id"), knownvalue.NotNull()),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name[99]", tfjsonpath.New("name"), knownvalue.StringRegexp(regexp.MustCompile("test_team_[0-9]+_by_name"))),
@@ -511,8 +532,8 @@ resource "forgejo_team" "test_by_name" {
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name[99]", tfjsonpath.New("description"), knownvalue.StringExact("")),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name[99]", tfjsonpath.New("includes_all_repositories"), knownvalue.Bool(false)),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name[99]", tfjsonpath.New("permission"), knownvalue.StringExact("read")),
-					statecheck.ExpectKnownValue("forgejo_team.test_by_name[99]", tfjsonpath.New("units_map"), knownvalue.MapExact(map[string]knownvalue.Check{
-						"repo.code": knownvalue.StringExact("read"),
+					statecheck.ExpectKnownValue("forgejo_team.test_by_name[99]", tfjsonpath.New("units"), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"code": knownvalue.StringExact("read"),
 					})),
 				},
 			},
@@ -528,8 +549,8 @@ resource "forgejo_team" "test_by_name" {
 	organization = forgejo_organization.test[99].name
 	name         = "test_team_99_by_name"
 
-	units_map = {
-		"repo.code" = "read"
+	units = {
+		code = "read"
 	}
 }`,
 				ConfigPlanChecks: resource.ConfigPlanChecks{
@@ -546,8 +567,8 @@ resource "forgejo_team" "test_by_name" {
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("description"), knownvalue.StringExact("")),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("includes_all_repositories"), knownvalue.Bool(false)),
 					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("permission"), knownvalue.StringExact("read")),
-					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("units_map"), knownvalue.MapExact(map[string]knownvalue.Check{
-						"repo.code": knownvalue.StringExact("read"),
+					statecheck.ExpectKnownValue("forgejo_team.test_by_name", tfjsonpath.New("units"), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+						"code": knownvalue.StringExact("read"),
 					})),
 				},
 			},
@@ -555,3 +576,74 @@ resource "forgejo_team" "test_by_name" {
 		},
 	})
 }
+
+func TestTeamResourceUpgradeStateOwnerTeam(t *testing.T) {
+	ctx := context.Background()
+
+	r, ok := provider.NewTeamResource().(fwresource.ResourceWithUpgradeState)
+	if !ok {
+		t.Fatal("team resource does not implement state upgrades")
+	}
+	upgrader := r.UpgradeState(ctx)[0]
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	// Prior state of an owner team (version 0)
+	priorType := upgrader.PriorSchema.Type().TerraformType(ctx)
+	prior := tftypes.NewValue(priorType, map[string]tftypes.Value{
+		"id":                        tftypes.NewValue(tftypes.Number, 1),
+		"name":                      tftypes.NewValue(tftypes.String, "Owners"),
+		"organization":              tftypes.NewValue(tftypes.String, "test_org"),
+		"organization_id":           tftypes.NewValue(tftypes.Number, 2),
+		"can_create_org_repo":       tftypes.NewValue(tftypes.Bool, true),
+		"description":               tftypes.NewValue(tftypes.String, ""),
+		"includes_all_repositories": tftypes.NewValue(tftypes.Bool, true),
+		"permission":                tftypes.NewValue(tftypes.String, "owner"),
+		"units_map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"repo.code":   tftypes.NewValue(tftypes.String, "owner"),
+			"repo.issues": tftypes.NewValue(tftypes.String, "owner"),
+		}),
+	})
+
+	req := fwresource.UpgradeStateRequest{
+		State: &tfsdk.State{
+			Raw:    prior,
+			Schema: *upgrader.PriorSchema,
+		},
+	}
+	resp := fwresource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			Schema: schemaResp.Schema,
+		},
+	}
+	upgrader.StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	for unit, expected := range map[string]string{
+		"code":    "owner",
+		"issues":  "owner",
+		"actions": "none",
+	} {
+		var actual types.String
+		diags := resp.State.GetAttribute(ctx, path.Root("units").AtName(unit), &actual)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if actual.ValueString() != expected {
+			t.Errorf("units.%s: expected %q, got %q", unit, expected, actual.ValueString())
+		}
+	}
+
+	var permission types.String
+	diags := resp.State.GetAttribute(ctx, path.Root("permission"), &permission)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if permission.ValueString() != "owner" {
+		t.Errorf("permission: expected %q, got %q", "owner", permission.ValueString())
+	}
+}
@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &teamsDataSource{}
+	_ datasource.DataSourceWithConfigure = &teamsDataSource{}
+)
+
+// teamsDataSource is the data source implementation.
+type teamsDataSource struct {
+	client *forgejo.Client
+}
+
+// teamsDataSourceModel maps the data source schema data.
+type teamsDataSourceModel struct {
+	Organization   types.String `tfsdk:"organization"`
+	OrganizationID types.Int64  `tfsdk:"organization_id"`
+	Teams          types.List   `tfsdk:"teams"`
+}
+
+// teamsDataSourceTeam maps a single organization team.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#Team
+type teamsDataSourceTeam struct {
+	ID                      types.Int64  `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Description             types.String `tfsdk:"description"`
+	CanCreateOrgRepo        types.Bool   `tfsdk:"can_create_org_repo"`
+	IncludesAllRepositories types.Bool   `tfsdk:"includes_all_repositories"`
+	Permission              types.String `tfsdk:"permission"`
+	Units                   types.Object `tfsdk:"units"`
+}
+
+func (m teamsDataSourceTeam) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                        types.Int64Type,
+		"name":                      types.StringType,
+		"description":               types.StringType,
+		"can_create_org_repo":       types.BoolType,
+		"includes_all_repositories": types.BoolType,
+		"permission":                types.StringType,
+		"units":                     types.ObjectType{AttrTypes: teamResourceUnits{}.attributeTypes()},
+	}
+}
+
+// from is a helper function to load an API struct into Terraform data model.
+func (m *teamsDataSourceTeam) from(ctx context.Context, t *forgejo.Team) (diags diag.Diagnostics) {
+	m.ID = types.Int64Value(t.ID)
+	m.Name = types.StringValue(t.Name)
+	m.Description = types.StringValue(t.Description)
+	m.CanCreateOrgRepo = types.BoolValue(t.CanCreateOrgRepo)
+	m.IncludesAllRepositories = types.BoolValue(t.IncludesAllRepositories)
+	m.Permission = types.StringValue(string(t.Permission))
+
+	var units teamResourceUnits
+	units.from(t.UnitsMap)
+	m.Units, diags = types.ObjectValueFrom(ctx, units.attributeTypes(), units)
+
+	return diags
+}
+
+// teamUnitsDataSourceAttribute returns the schema of the access levels to the
+// repository units of a team.
+func teamUnitsDataSourceAttribute() schema.SingleNestedAttribute {
+	units := make(map[string]schema.Attribute, len(teamUnits))
+	for _, u := range teamUnits {
+		units[u.name] = schema.StringAttribute{
+			Description: "Access level to " + u.description + ".",
+			Computed:    true,
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		Attributes:  units,
+		Description: "Access levels to the repository units (" + teamUnitValuesDescription() + ").",
+		Computed:    true,
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *teamsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+// Schema defines the schema for the data source.
+func (d *teamsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forgejo teams data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Name of the owning organization. **Note**: One of `organization` or `organization_id` must be specified.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("organization_id"),
+					}...),
+				},
+			},
+			"organization_id": schema.Int64Attribute{
+				MarkdownDescription: "Numeric identifier of the owning organization. **Note**: One of `organization` or `organization_id` must be specified.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.Expressions{
+						path.MatchRoot("organization"),
+					}...),
+				},
+			},
+			"teams": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Numeric identifier of the team.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the team.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the team.",
+							Computed:    true,
+						},
+						"can_create_org_repo": schema.BoolAttribute{
+							Description: "Can create repositories?",
+							Computed:    true,
+						},
+						"includes_all_repositories": schema.BoolAttribute{
+							Description: "Has access to all repositories?",
+							Computed:    true,
+						},
+						"permission": schema.StringAttribute{
+							Description: "Permissions within the owning organization.",
+							Computed:    true,
+						},
+						"units": teamUnitsDataSourceAttribute(),
+					},
+				},
+				Description: "Teams of the organization, sorted by name.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *teamsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *teamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer un(trace(ctx, "Read teams data source"))
+
+	var data teamsDataSourceModel
+
+	// Read Terraform configuration data into model
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get organization by ID or name
+	var org *forgejo.Organization
+	if data.Organization.IsNull() || data.Organization.IsUnknown() {
+		org, diags = getOrganizationByID(
+			ctx,
+			d.client,
+			data.OrganizationID.ValueInt64(),
+		)
+	} else {
+		org, diags = getOrganizationByName(
+			ctx,
+			d.client,
+			data.Organization.ValueString(),
+		)
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Organization = types.StringValue(org.UserName)
+	data.OrganizationID = types.Int64Value(org.ID)
+
+	// Use Forgejo client to list organization teams
+	orgTeams, diags := listOrgTeams(ctx, d.client, org.UserName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sort teams by name for a stable order
+	slices.SortFunc(orgTeams, func(a, b *forgejo.Team) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	// Map response body to model
+	teams := make([]teamsDataSourceTeam, len(orgTeams))
+	for i, t := range orgTeams {
+		diags = teams[i].from(ctx, t)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	data.Teams, diags = types.ListValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: teamsDataSourceTeam{}.attributeTypes()},
+		teams,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewTeamsDataSource is a helper function to simplify the provider implementation.
+func NewTeamsDataSource() datasource.DataSource {
+	return &teamsDataSource{}
+}
+
+// listOrgTeams is a helper function to list all teams of an organization.
+func listOrgTeams(ctx context.Context, client *forgejo.Client, org string) ([]*forgejo.Team, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "List organization teams", map[string]any{
+		"organization": org,
+	})
+
+	// Use Forgejo client to list organization teams
+	teams, res, err := listAllPages(func(opts forgejo.ListOptions) ([]*forgejo.Team, *forgejo.Response, error) {
+		return client.ListOrgTeams(org, forgejo.ListTeamsOptions{
+			ListOptions: opts,
+		})
+	})
+	if err == nil {
+		return teams, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 404:
+			msg = fmt.Sprintf(
+				"Organization with name '%s' not found: %s",
+				org,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to list organization teams", msg)
+
+	return nil, diags
+}
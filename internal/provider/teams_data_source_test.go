@@ -0,0 +1,99 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTeamsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing (non-existent org by ID)
+			{
+				Config: providerConfig + `
+data "forgejo_teams" "test" {
+	organization_id = 1011
+}`,
+				ExpectError: regexp.MustCompile("Organization with ID 1011 not found"),
+			},
+			// Read testing (non-existent org by name)
+			{
+				Config: providerConfig + `
+data "forgejo_teams" "test" {
+	organization = "non-existent"
+}`,
+				ExpectError: regexp.MustCompile("Organization with name 'non-existent' not found"),
+			},
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_organization" "test" {
+	name = "test_org"
+}
+resource "forgejo_team" "test" {
+	name                      = "test_team"
+	organization_id           = forgejo_organization.test.id
+	description               = "Test team"
+	includes_all_repositories = true
+	permission                = "write"
+	units                     = {
+		code   = "write"
+		issues = "read"
+	}
+}
+data "forgejo_teams" "test_by_id" {
+	organization_id = forgejo_organization.test.id
+	depends_on      = [forgejo_team.test]
+}
+data "forgejo_teams" "test_by_name" {
+	organization = forgejo_organization.test.name
+	depends_on   = [forgejo_team.test]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("data.forgejo_teams.test_by_id", plancheck.ResourceActionRead),
+						plancheck.ExpectResourceAction("data.forgejo_teams.test_by_name", plancheck.ResourceActionRead),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.forgejo_teams.test_by_id", tfjsonpath.New("organization"), knownvalue.StringExact("test_org")),
+					statecheck.ExpectKnownValue("data.forgejo_teams.test_by_name", tfjsonpath.New("organization_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("data.forgejo_teams.test_by_name", tfjsonpath.New("teams"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"name":       knownvalue.StringExact("Owners"),
+							"permission": knownvalue.StringExact("owner"),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"id":                        knownvalue.NotNull(),
+							"name":                      knownvalue.StringExact("test_team"),
+							"description":               knownvalue.StringExact("Test team"),
+							"can_create_org_repo":       knownvalue.NotNull(),
+							"includes_all_repositories": knownvalue.Bool(true),
+							"permission":                knownvalue.StringExact("write"),
+							"units": knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"code":       knownvalue.StringExact("write"),
+								"issues":     knownvalue.StringExact("read"),
+								"pulls":      knownvalue.StringExact("none"),
+								"releases":   knownvalue.StringExact("none"),
+								"wiki":       knownvalue.StringExact("none"),
+								"ext_wiki":   knownvalue.StringExact("none"),
+								"ext_issues": knownvalue.StringExact("none"),
+								"projects":   knownvalue.StringExact("none"),
+								"packages":   knownvalue.StringExact("none"),
+								"actions":    knownvalue.StringExact("none"),
+							}),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &userActionSecretResource{}
+	_ resource.ResourceWithConfigure = &userActionSecretResource{}
+)
+
+// userActionSecretResource is the resource implementation.
+type userActionSecretResource struct {
+	client *forgejo.Client
+}
+
+// userActionSecretResourceModel maps the resource schema data.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#CreateSecretOption
+type userActionSecretResourceModel struct {
+	Name types.String `tfsdk:"name"`
+	Data types.String `tfsdk:"data"`
+}
+
+// to is a helper function to save Terraform data model into an API struct.
+func (m *userActionSecretResourceModel) to(o *forgejo.CreateSecretOption) {
+	if o == nil {
+		return
+	}
+
+	o.Name = m.Name.ValueString()
+	o.Data = m.Data.ValueString()
+}
+
+// Metadata returns the resource type name.
+func (r *userActionSecretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_action_secret"
+}
+
+// Schema defines the schema for the resource.
+func (r *userActionSecretResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo user action secret resource.
+
+Manages action secrets of the authenticated user, which are available to the workflows of all repositories owned by the user.
+
+**Note**: Forgejo does not provide an API to list user action secrets, hence changes made outside of Terraform are not detected!`,
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the secret. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"data": schema.StringAttribute{
+				// Write-only attribute
+				Description: "Data of the secret.",
+				Required:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userActionSecretResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userActionSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer un(trace(ctx, "Create user action secret resource"))
+
+	var data userActionSecretResourceModel
+
+	// Read Terraform plan data into the model
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Create user action secret", map[string]any{
+		"name": data.Name.ValueString(),
+		"data": strings.Repeat("*", len(data.Data.ValueString())),
+	})
+
+	// Generate API request body from plan
+	opts := forgejo.CreateSecretOption{}
+	data.to(&opts)
+
+	// Validate API request body
+	err := opts.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError("Input validation error", err.Error())
+
+		return
+	}
+
+	// Use Forgejo client to create new user action secret
+	res, err := r.client.CreateUserActionSecret(opts)
+	if err != nil {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			tflog.Error(ctx, "Error", map[string]any{
+				"status": res.Status,
+			})
+
+			switch res.StatusCode {
+			case 400:
+				msg = fmt.Sprintf("Bad request: %s", err)
+			default:
+				msg = fmt.Sprintf(
+					"Unknown error (status %d): %s",
+					res.StatusCode,
+					err,
+				)
+			}
+		}
+		resp.Diagnostics.AddError("Unable to create user action secret", msg)
+
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userActionSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer un(trace(ctx, "Read user action secret resource"))
+
+	var data userActionSecretResourceModel
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	/*
+	 * User action secrets can not be read back. Forgejo neither returns the
+	 * secret data nor offers an API to list the secrets of a user.
+	 */
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *userActionSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer un(trace(ctx, "Update user action secret resource"))
+
+	var plan userActionSecretResourceModel
+
+	// Read Terraform plan data into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Update user action secret", map[string]any{
+		"name": plan.Name.ValueString(),
+		"data": strings.Repeat("*", len(plan.Data.ValueString())),
+	})
+
+	// Generate API request body from plan
+	opts := forgejo.CreateSecretOption{}
+	plan.to(&opts)
+
+	// Validate API request body
+	err := opts.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError("Input validation error", err.Error())
+
+		return
+	}
+
+	// Use Forgejo client to update user action secret
+	res, err := r.client.CreateUserActionSecret(opts)
+	if err != nil {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			tflog.Error(ctx, "Error", map[string]any{
+				"status": res.Status,
+			})
+
+			switch res.StatusCode {
+			case 400:
+				msg = fmt.Sprintf("Bad request: %s", err)
+			default:
+				msg = fmt.Sprintf(
+					"Unknown error (status %d): %s",
+					res.StatusCode,
+					err,
+				)
+			}
+		}
+		resp.Diagnostics.AddError("Unable to update user action secret", msg)
+
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *userActionSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer un(trace(ctx, "Delete user action secret resource"))
+
+	var data userActionSecretResourceModel
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Delete user action secret", map[string]any{
+		"name": data.Name.ValueString(),
+	})
+
+	// Use Forgejo client to delete existing user action secret
+	res, err := r.client.DeleteUserActionSecret(data.Name.ValueString())
+	if err != nil {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			tflog.Error(ctx, "Error", map[string]any{
+				"status": res.Status,
+			})
+
+			switch res.StatusCode {
+			case 400:
+				msg = fmt.Sprintf("Bad request: %s", err)
+			case 404:
+				msg = fmt.Sprintf(
+					"User action secret with name %s not found: %s",
+					data.Name.String(),
+					err,
+				)
+			default:
+				msg = fmt.Sprintf(
+					"Unknown error (status %d): %s",
+					res.StatusCode,
+					err,
+				)
+			}
+		}
+		resp.Diagnostics.AddError("Unable to delete user action secret", msg)
+
+		return
+	}
+}
+
+// NewUserActionSecretResource is a helper function to simplify the provider implementation.
+func NewUserActionSecretResource() resource.Resource {
+	return &userActionSecretResource{}
+}
@@ -0,0 +1,72 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccUserActionSecretResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_user_action_secret" "test" {
+	name = "my_secret"
+	data = "my_secret_value"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_user_action_secret.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_user_action_secret.test", tfjsonpath.New("name"), knownvalue.StringExact("my_secret")),
+					statecheck.ExpectSensitiveValue("forgejo_user_action_secret.test", tfjsonpath.New("data")),
+				},
+			},
+			// Recreate and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_user_action_secret" "test" {
+	name = "my_new_secret"
+	data = "my_secret_value"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_user_action_secret.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_user_action_secret.test", tfjsonpath.New("name"), knownvalue.StringExact("my_new_secret")),
+					statecheck.ExpectSensitiveValue("forgejo_user_action_secret.test", tfjsonpath.New("data")),
+				},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_user_action_secret" "test" {
+	name = "my_new_secret"
+	data = "my_new_secret_value"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_user_action_secret.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_user_action_secret.test", tfjsonpath.New("name"), knownvalue.StringExact("my_new_secret")),
+					statecheck.ExpectSensitiveValue("forgejo_user_action_secret.test", tfjsonpath.New("data")),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,394 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &userActionVariableResource{}
+	_ resource.ResourceWithConfigure = &userActionVariableResource{}
+)
+
+// userActionVariableResource is the resource implementation.
+type userActionVariableResource struct {
+	client *forgejo.Client
+}
+
+// userActionVariableResourceModel maps the resource schema data.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#CreateVariableOption
+type userActionVariableResourceModel struct {
+	Name types.String `tfsdk:"name"`
+	Data types.String `tfsdk:"data"`
+}
+
+// from is a helper function to load an API struct into Terraform data model.
+func (m *userActionVariableResourceModel) from(v *forgejo.ActionVariable) {
+	if v == nil {
+		return
+	}
+
+	// Name is omitted here, to maintain the user's configuration casing
+	m.Data = types.StringValue(v.Data)
+}
+
+// to is a helper function to save Terraform data model into an API struct.
+func (m *userActionVariableResourceModel) to(o *forgejo.CreateVariableOption) {
+	if o == nil {
+		return
+	}
+
+	o.Name = m.Name.ValueString()
+	o.Data = m.Data.ValueString()
+}
+
+// Metadata returns the resource type name.
+func (r *userActionVariableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_action_variable"
+}
+
+// Schema defines the schema for the resource.
+func (r *userActionVariableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo user action variable resource.
+
+Manages action variables of the authenticated user, which are available to the workflows of all repositories owned by the user.`,
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the variable.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"data": schema.StringAttribute{
+				Description: "Data of the variable.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userActionVariableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userActionVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer un(trace(ctx, "Create user action variable resource"))
+
+	var data userActionVariableResourceModel
+
+	// Read Terraform plan data into model
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Create user action variable", map[string]any{
+		"name": data.Name.ValueString(),
+		"data": data.Data.ValueString(),
+	})
+
+	// Generate API request body from plan
+	opts := forgejo.CreateVariableOption{}
+	data.to(&opts)
+
+	// Validate API request body
+	err := opts.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError("Input validation error", err.Error())
+
+		return
+	}
+
+	// Use Forgejo client to create new user action variable
+	res, err := r.client.CreateUserActionVariable(opts)
+	if err != nil {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			tflog.Error(ctx, "Error", map[string]any{
+				"status": res.Status,
+			})
+
+			switch res.StatusCode {
+			case 400:
+				msg = fmt.Sprintf("Bad request: %s", err)
+			case 409:
+				msg = fmt.Sprintf(
+					"User action variable with name %s conflict: %s",
+					data.Name.String(),
+					err,
+				)
+			default:
+				msg = fmt.Sprintf(
+					"Unknown error (status %d): %s",
+					res.StatusCode,
+					err,
+				)
+			}
+		}
+		resp.Diagnostics.AddError("Unable to create user action variable", msg)
+
+		return
+	}
+
+	// Use Forgejo client to get user action variable
+	variable, diags := r.getVariable(ctx, data.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	data.from(variable)
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userActionVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer un(trace(ctx, "Read user action variable resource"))
+
+	var data userActionVariableResourceModel
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get user action variable
+	variable, diags := r.getVariable(ctx, data.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	data.from(variable)
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *userActionVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer un(trace(ctx, "Update user action variable resource"))
+
+	var (
+		state userActionVariableResourceModel
+		plan  userActionVariableResourceModel
+	)
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform plan data into the model
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Update user action variable", map[string]any{
+		"old_name": state.Name.ValueString(),
+		"new_name": plan.Name.ValueString(),
+		"data":     plan.Data.ValueString(),
+	})
+
+	// Generate API request body from plan
+	opts := forgejo.CreateVariableOption{}
+	plan.to(&opts)
+
+	// Validate API request body
+	err := opts.Validate()
+	if err != nil {
+		resp.Diagnostics.AddError("Input validation error", err.Error())
+
+		return
+	}
+
+	// Use Forgejo client to update user action variable
+	res, err := r.client.UpdateUserActionVariable(
+		state.Name.ValueString(),
+		opts,
+	)
+	if err != nil {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			tflog.Error(ctx, "Error", map[string]any{
+				"status": res.Status,
+			})
+
+			switch res.StatusCode {
+			case 400:
+				msg = fmt.Sprintf("Bad request: %s", err)
+			case 404:
+				msg = fmt.Sprintf(
+					"User action variable with name %s not found: %s",
+					state.Name.String(),
+					err,
+				)
+			default:
+				msg = fmt.Sprintf(
+					"Unknown error (status %d): %s",
+					res.StatusCode,
+					err,
+				)
+			}
+		}
+		resp.Diagnostics.AddError("Unable to update user action variable", msg)
+
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *userActionVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer un(trace(ctx, "Delete user action variable resource"))
+
+	var data userActionVariableResourceModel
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Delete user action variable", map[string]any{
+		"name": data.Name.ValueString(),
+	})
+
+	// Use Forgejo client to delete existing user action variable
+	res, err := r.client.DeleteUserActionVariable(data.Name.ValueString())
+	if err != nil {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			tflog.Error(ctx, "Error", map[string]any{
+				"status": res.Status,
+			})
+
+			switch res.StatusCode {
+			case 400:
+				msg = fmt.Sprintf("Bad request: %s", err)
+			case 404:
+				msg = fmt.Sprintf(
+					"User action variable with name %s not found: %s",
+					data.Name.String(),
+					err,
+				)
+			default:
+				msg = fmt.Sprintf(
+					"Unknown error (status %d): %s",
+					res.StatusCode,
+					err,
+				)
+			}
+		}
+		resp.Diagnostics.AddError("Unable to delete user action variable", msg)
+
+		return
+	}
+}
+
+// NewUserActionVariableResource is a helper function to simplify the provider implementation.
+func NewUserActionVariableResource() resource.Resource {
+	return &userActionVariableResource{}
+}
+
+// getVariable returns the variable with the given name from the authenticated user.
+func (r *userActionVariableResource) getVariable(ctx context.Context, name string) (*forgejo.ActionVariable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Read user action variable", map[string]any{
+		"name": name,
+	})
+
+	// Use Forgejo client to get user action variable
+	variable, res, err := r.client.GetUserActionVariable(name)
+	if err == nil {
+		return variable, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 400:
+			msg = fmt.Sprintf("Bad request: %s", err)
+		case 404:
+			msg = fmt.Sprintf(
+				"User action variable with name '%s' not found: %s",
+				name,
+				err,
+			)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to read user action variable", msg)
+
+	return nil, diags
+}
@@ -0,0 +1,86 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccUserActionVariableResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_user_action_variable" "test" {
+	name = "my_variable"
+	data = "my_variable_value"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_user_action_variable.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_user_action_variable.test", tfjsonpath.New("name"), knownvalue.StringExact("my_variable")),
+					statecheck.ExpectKnownValue("forgejo_user_action_variable.test", tfjsonpath.New("data"), knownvalue.StringExact("my_variable_value")),
+				},
+			},
+			// Create and Read testing (duplicate name)
+			{
+				Config: providerConfig + `
+resource "forgejo_user_action_variable" "test" {
+	name = "my_variable"
+	data = "my_variable_value"
+}
+resource "forgejo_user_action_variable" "duplicate" {
+	name = forgejo_user_action_variable.test.name
+	data = "my_other_variable_value"
+}`,
+				ExpectError: regexp.MustCompile(`User action variable with name "my_variable" conflict`),
+			},
+			// Update and Read testing (rename variable)
+			{
+				Config: providerConfig + `
+resource "forgejo_user_action_variable" "test" {
+	name = "my_new_variable"
+	data = "my_variable_value"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_user_action_variable.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_user_action_variable.test", tfjsonpath.New("name"), knownvalue.StringExact("my_new_variable")),
+					statecheck.ExpectKnownValue("forgejo_user_action_variable.test", tfjsonpath.New("data"), knownvalue.StringExact("my_variable_value")),
+				},
+			},
+			// Update and Read testing (update value)
+			{
+				Config: providerConfig + `
+resource "forgejo_user_action_variable" "test" {
+	name = "my_new_variable"
+	data = "my_new_variable_value"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_user_action_variable.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_user_action_variable.test", tfjsonpath.New("name"), knownvalue.StringExact("my_new_variable")),
+					statecheck.ExpectKnownValue("forgejo_user_action_variable.test", tfjsonpath.New("data"), knownvalue.StringExact("my_new_variable_value")),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -52,6 +53,63 @@ type userDataSourceModel struct {
 	StarredRepoCount types.Int64  `tfsdk:"starred_repos_count"`
 }
 
+func (m userDataSourceModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                  types.Int64Type,
+		"login":               types.StringType,
+		"login_name":          types.StringType,
+		"source_id":           types.Int64Type,
+		"full_name":           types.StringType,
+		"email":               types.StringType,
+		"html_url":            types.StringType,
+		"avatar_url":          types.StringType,
+		"language":            types.StringType,
+		"admin":               types.BoolType,
+		"last_login":          types.StringType,
+		"created_at":          types.StringType,
+		"restricted":          types.BoolType,
+		"active":              types.BoolType,
+		"prohibit_login":      types.BoolType,
+		"location":            types.StringType,
+		"website":             types.StringType,
+		"description":         types.StringType,
+		"visibility":          types.StringType,
+		"followers_count":     types.Int64Type,
+		"following_count":     types.Int64Type,
+		"starred_repos_count": types.Int64Type,
+	}
+}
+
+// from is a helper function to load an API struct into Terraform data model.
+func (m *userDataSourceModel) from(usr *forgejo.User) {
+	if usr == nil {
+		return
+	}
+
+	m.ID = types.Int64Value(usr.ID)
+	m.Name = types.StringValue(usr.UserName)
+	m.LoginName = types.StringValue(usr.LoginName)
+	m.SourceID = types.Int64Value(usr.SourceID)
+	m.FullName = types.StringValue(usr.FullName)
+	m.Email = types.StringValue(usr.Email)
+	m.HTMLURL = types.StringValue(usr.HTMLURL)
+	m.AvatarURL = types.StringValue(usr.AvatarURL)
+	m.Language = types.StringValue(usr.Language)
+	m.Admin = types.BoolValue(usr.IsAdmin)
+	m.LastLogin = types.StringValue(usr.LastLogin.Format(time.RFC3339))
+	m.Created = types.StringValue(usr.Created.Format(time.RFC3339))
+	m.Restricted = types.BoolValue(usr.Restricted)
+	m.Active = types.BoolValue(usr.IsActive)
+	m.ProhibitLogin = types.BoolValue(usr.ProhibitLogin)
+	m.Location = types.StringValue(usr.Location)
+	m.Website = types.StringValue(usr.Website)
+	m.Description = types.StringValue(usr.Description)
+	m.Visibility = types.StringValue(string(usr.Visibility))
+	m.FollowerCount = types.Int64Value(int64(usr.FollowerCount))
+	m.FollowingCount = types.Int64Value(int64(usr.FollowingCount))
+	m.StarredRepoCount = types.Int64Value(int64(usr.StarredRepoCount))
+}
+
 // Metadata returns the data source type name.
 func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user"
@@ -59,98 +117,110 @@ func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 
 // Schema defines the schema for the data source.
 func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := userDataSourceAttributes()
+	attributes["login"] = schema.StringAttribute{
+		Description: "Name of the user.",
+		Required:    true,
+	}
+
 	resp.Schema = schema.Schema{
 		Description: "Forgejo user data source.",
 
-		Attributes: map[string]schema.Attribute{
-			"id": schema.Int64Attribute{
-				Description: "Numeric identifier of the user.",
-				Computed:    true,
-			},
-			"login": schema.StringAttribute{
-				Description: "Name of the user.",
-				Required:    true,
-			},
-			"login_name": schema.StringAttribute{
-				Description: "Login name of the user.",
-				Computed:    true,
-			},
-			"source_id": schema.Int64Attribute{
-				Description: "Numeric identifier of the user's authentication source.",
-				Computed:    true,
-			},
-			"full_name": schema.StringAttribute{
-				Description: "Full name of the user.",
-				Computed:    true,
-			},
-			"email": schema.StringAttribute{
-				Description: "Email address of the user.",
-				Computed:    true,
-			},
-			"avatar_url": schema.StringAttribute{
-				Description: "Avatar URL of the user.",
-				Computed:    true,
-			},
-			"html_url": schema.StringAttribute{
-				Description: "URL to the user's profile page.",
-				Computed:    true,
-			},
-			"language": schema.StringAttribute{
-				Description: "Locale of the user.",
-				Computed:    true,
-			},
-			"admin": schema.BoolAttribute{
-				Description: "Is the user an administrator?",
-				Computed:    true,
-			},
-			"last_login": schema.StringAttribute{
-				Description: "Time at which the user last logged in.",
-				Computed:    true,
-			},
-			"created_at": schema.StringAttribute{
-				Description: "Time at which the user was created.",
-				Computed:    true,
-			},
-			"restricted": schema.BoolAttribute{
-				Description: "Is the user restricted?",
-				Computed:    true,
-			},
-			"active": schema.BoolAttribute{
-				Description: "Is the user active?",
-				Computed:    true,
-			},
-			"prohibit_login": schema.BoolAttribute{
-				Description: "Are user logins prohibited?",
-				Computed:    true,
-			},
-			"location": schema.StringAttribute{
-				Description: "Location of the user.",
-				Computed:    true,
-			},
-			"website": schema.StringAttribute{
-				Description: "Website of the user.",
-				Computed:    true,
-			},
-			"description": schema.StringAttribute{
-				Description: "Description of the user.",
-				Computed:    true,
-			},
-			"visibility": schema.StringAttribute{
-				Description: "Visibility of the user.",
-				Computed:    true,
-			},
-			"followers_count": schema.Int64Attribute{
-				Description: "Number of following users.",
-				Computed:    true,
-			},
-			"following_count": schema.Int64Attribute{
-				Description: "Number of users followed.",
-				Computed:    true,
-			},
-			"starred_repos_count": schema.Int64Attribute{
-				Description: "Number of starred repositories.",
-				Computed:    true,
-			},
+		Attributes: attributes,
+	}
+}
+
+// userDataSourceAttributes returns the computed user attributes
+// shared by the user and users data sources.
+func userDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Numeric identifier of the user.",
+			Computed:    true,
+		},
+		"login": schema.StringAttribute{
+			Description: "Name of the user.",
+			Computed:    true,
+		},
+		"login_name": schema.StringAttribute{
+			Description: "Login name of the user.",
+			Computed:    true,
+		},
+		"source_id": schema.Int64Attribute{
+			Description: "Numeric identifier of the user's authentication source.",
+			Computed:    true,
+		},
+		"full_name": schema.StringAttribute{
+			Description: "Full name of the user.",
+			Computed:    true,
+		},
+		"email": schema.StringAttribute{
+			Description: "Email address of the user.",
+			Computed:    true,
+		},
+		"avatar_url": schema.StringAttribute{
+			Description: "Avatar URL of the user.",
+			Computed:    true,
+		},
+		"html_url": schema.StringAttribute{
+			Description: "URL to the user's profile page.",
+			Computed:    true,
+		},
+		"language": schema.StringAttribute{
+			Description: "Locale of the user.",
+			Computed:    true,
+		},
+		"admin": schema.BoolAttribute{
+			Description: "Is the user an administrator?",
+			Computed:    true,
+		},
+		"last_login": schema.StringAttribute{
+			Description: "Time at which the user last logged in.",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "Time at which the user was created.",
+			Computed:    true,
+		},
+		"restricted": schema.BoolAttribute{
+			Description: "Is the user restricted?",
+			Computed:    true,
+		},
+		"active": schema.BoolAttribute{
+			Description: "Is the user active?",
+			Computed:    true,
+		},
+		"prohibit_login": schema.BoolAttribute{
+			Description: "Are user logins prohibited?",
+			Computed:    true,
+		},
+		"location": schema.StringAttribute{
+			Description: "Location of the user.",
+			Computed:    true,
+		},
+		"website": schema.StringAttribute{
+			Description: "Website of the user.",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Description of the user.",
+			Computed:    true,
+		},
+		"visibility": schema.StringAttribute{
+			Description: "Visibility of the user.",
+			Computed:    true,
+		},
+		"followers_count": schema.Int64Attribute{
+			Description: "Number of following users.",
+			Computed:    true,
+		},
+		"following_count": schema.Int64Attribute{
+			Description: "Number of users followed.",
+			Computed:    true,
+		},
+		"starred_repos_count": schema.Int64Attribute{
+			Description: "Number of starred repositories.",
+			Computed:    true,
 		},
 	}
 }
@@ -203,28 +273,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 
 	// Map response body to model
-	data.ID = types.Int64Value(usr.ID)
-	data.Name = types.StringValue(usr.UserName)
-	data.LoginName = types.StringValue(usr.LoginName)
-	data.SourceID = types.Int64Value(usr.SourceID)
-	data.FullName = types.StringValue(usr.FullName)
-	data.Email = types.StringValue(usr.Email)
-	data.HTMLURL = types.StringValue(usr.HTMLURL)
-	data.AvatarURL = types.StringValue(usr.AvatarURL)
-	data.Language = types.StringValue(usr.Language)
-	data.Admin = types.BoolValue(usr.IsAdmin)
-	data.LastLogin = types.StringValue(usr.LastLogin.Format(time.RFC3339))
-	data.Created = types.StringValue(usr.Created.Format(time.RFC3339))
-	data.Restricted = types.BoolValue(usr.Restricted)
-	data.Active = types.BoolValue(usr.IsActive)
-	data.ProhibitLogin = types.BoolValue(usr.ProhibitLogin)
-	data.Location = types.StringValue(usr.Location)
-	data.Website = types.StringValue(usr.Website)
-	data.Description = types.StringValue(usr.Description)
-	data.Visibility = types.StringValue(string(usr.Visibility))
-	data.FollowerCount = types.Int64Value(int64(usr.FollowerCount))
-	data.FollowingCount = types.Int64Value(int64(usr.FollowingCount))
-	data.StarredRepoCount = types.Int64Value(int64(usr.StarredRepoCount))
+	data.from(usr)
 
 	// Save data into Terraform state
 	diags = resp.State.Set(ctx, &data)
@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &userEmailResource{}
+	_ resource.ResourceWithConfigure   = &userEmailResource{}
+	_ resource.ResourceWithImportState = &userEmailResource{}
+)
+
+// userEmailResource is the resource implementation.
+type userEmailResource struct {
+	client *forgejo.Client
+}
+
+// userEmailResourceModel maps the resource schema data.
+// https://pkg.go.dev/codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3#Email
+type userEmailResourceModel struct {
+	Email    types.String `tfsdk:"email"`
+	Verified types.Bool   `tfsdk:"verified"`
+	Primary  types.Bool   `tfsdk:"primary"`
+}
+
+// from is a helper function to load an API struct into Terraform data model.
+func (m *userEmailResourceModel) from(e *forgejo.Email) {
+	if e == nil {
+		return
+	}
+
+	m.Verified = types.BoolValue(e.Verified)
+	m.Primary = types.BoolValue(e.Primary)
+
+	// Email intentionally omitted (compared case-insensitively)
+}
+
+// Metadata returns the resource type name.
+func (r *userEmailResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_email"
+}
+
+// Schema defines the schema for the resource.
+func (r *userEmailResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo user email resource.
+
+Manages additional email addresses of the authenticated user.
+
+**Note**: The primary email address is managed by the ` + "`email`" + ` attribute of the ` + "`forgejo_user`" + ` resource!`,
+
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Description: "Email address. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Has the email address been verified?",
+				Computed:    true,
+			},
+			"primary": schema.BoolAttribute{
+				Description: "Is this the primary email address?",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userEmailResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userEmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer un(trace(ctx, "Create user email resource"))
+
+	var data userEmailResourceModel
+
+	// Read Terraform plan data into model
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Create user email", map[string]any{
+		"email": data.Email.ValueString(),
+	})
+
+	// Generate API request body from plan
+	opts := forgejo.CreateEmailOption{
+		Emails: []string{data.Email.ValueString()},
+	}
+
+	// Use Forgejo client to add email address
+	emails, res, err := r.client.AddEmail(opts)
+	if err != nil {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			tflog.Error(ctx, "Error", map[string]any{
+				"status": res.Status,
+			})
+
+			switch res.StatusCode {
+			case 422:
+				msg = fmt.Sprintf(
+					"Email address '%s' is invalid or already in use: %s",
+					data.Email.ValueString(),
+					err,
+				)
+			default:
+				msg = fmt.Sprintf(
+					"Unknown error (status %d): %s",
+					res.StatusCode,
+					err,
+				)
+			}
+		}
+		resp.Diagnostics.AddError("Unable to create user email", msg)
+
+		return
+	}
+
+	// Search for email address in response
+	email, diags := findUserEmail(emails, data.Email.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	data.from(email)
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userEmailResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer un(trace(ctx, "Read user email resource"))
+
+	var data userEmailResourceModel
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to get email address
+	email, diags := getUserEmail(ctx, r.client, data.Email.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	data.from(email)
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *userEmailResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer un(trace(ctx, "Update user email resource"))
+
+	/*
+	 * User emails can not be updated in-place. All writable attributes have
+	 * 'RequiresReplace' plan modifier set.
+	 */
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *userEmailResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer un(trace(ctx, "Delete user email resource"))
+
+	var data userEmailResourceModel
+
+	// Read Terraform prior state data into the model
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Delete user email", map[string]any{
+		"email": data.Email.ValueString(),
+	})
+
+	// Generate API request body from state
+	opts := forgejo.DeleteEmailOption{
+		Emails: []string{data.Email.ValueString()},
+	}
+
+	// Use Forgejo client to delete email address
+	res, err := r.client.DeleteEmail(opts)
+	if err != nil {
+		var msg string
+		if res == nil {
+			msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+		} else {
+			tflog.Error(ctx, "Error", map[string]any{
+				"status": res.Status,
+			})
+
+			switch res.StatusCode {
+			case 404:
+				msg = fmt.Sprintf(
+					"Email address '%s' not found: %s",
+					data.Email.ValueString(),
+					err,
+				)
+			default:
+				msg = fmt.Sprintf(
+					"Unknown error (status %d): %s",
+					res.StatusCode,
+					err,
+				)
+			}
+		}
+		resp.Diagnostics.AddError("Unable to delete user email", msg)
+
+		return
+	}
+}
+
+// ImportState reads an existing resource and adds it to Terraform state on success.
+func (r *userEmailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	defer un(trace(ctx, "Import user email resource"))
+
+	var state userEmailResourceModel
+
+	// Use Forgejo client to get email address
+	email, diags := getUserEmail(ctx, r.client, req.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	state.Email = types.StringValue(email.Email)
+	state.from(email)
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewUserEmailResource is a helper function to simplify the provider implementation.
+func NewUserEmailResource() resource.Resource {
+	return &userEmailResource{}
+}
+
+// getUserEmail fetches an email address of the authenticated user and handles errors consistently.
+func getUserEmail(ctx context.Context, client *forgejo.Client, address string) (*forgejo.Email, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "List user emails", map[string]any{
+		"email": address,
+	})
+
+	// Use Forgejo client to list email addresses
+	emails, res, err := client.ListEmails(forgejo.ListEmailsOptions{
+		ListOptions: forgejo.ListOptions{
+			Page: -1,
+		},
+	})
+	if err == nil {
+		return findUserEmail(emails, address)
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		msg = fmt.Sprintf(
+			"Unknown error (status %d): %s",
+			res.StatusCode,
+			err,
+		)
+	}
+	diags.AddError("Unable to list user emails", msg)
+
+	return nil, diags
+}
+
+// findUserEmail searches a list of email addresses case-insensitively.
+func findUserEmail(emails []*forgejo.Email, address string) (*forgejo.Email, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	idx := slices.IndexFunc(emails, func(e *forgejo.Email) bool {
+		return strings.EqualFold(e.Email, address)
+	})
+	if idx == -1 {
+		diags.AddError(
+			"Unable to read user email",
+			fmt.Sprintf("Email address '%s' not found", address),
+		)
+
+		return nil, diags
+	}
+
+	return emails[idx], diags
+}
@@ -0,0 +1,78 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccUserEmailResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing (primary email address)
+			{
+				Config: providerConfig + `
+resource "forgejo_user_email" "test" {
+	email = "` + forgejoTestEmail + `"
+}`,
+				ExpectError: regexp.MustCompile("Email address '" + forgejoTestEmail + "' is invalid or already in use"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_user_email" "test" {
+	email = "tfadmin_secondary@localhost.localdomain"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_user_email.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_user_email.test", tfjsonpath.New("email"), knownvalue.StringExact("tfadmin_secondary@localhost.localdomain")),
+					statecheck.ExpectKnownValue("forgejo_user_email.test", tfjsonpath.New("verified"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("forgejo_user_email.test", tfjsonpath.New("primary"), knownvalue.Bool(false)),
+				},
+			},
+			// Import testing (non-existent email address)
+			{
+				ResourceName:  "forgejo_user_email.test",
+				ImportState:   true,
+				ImportStateId: "non_existent@localhost.localdomain",
+				ExpectError:   regexp.MustCompile("Email address 'non_existent@localhost.localdomain' not found"),
+			},
+			// Import testing
+			{
+				ResourceName:                         "forgejo_user_email.test",
+				ImportState:                          true,
+				ImportStateId:                        "tfadmin_secondary@localhost.localdomain",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "email",
+			},
+			// Recreate and Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_user_email" "test" {
+	email = "tfadmin_tertiary@localhost.localdomain"
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forgejo_user_email.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("forgejo_user_email.test", tfjsonpath.New("email"), knownvalue.StringExact("tfadmin_tertiary@localhost.localdomain")),
+					statecheck.ExpectKnownValue("forgejo_user_email.test", tfjsonpath.New("primary"), knownvalue.Bool(false)),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -163,7 +163,7 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"login": schema.StringAttribute{
-				Description: "Name of the user. Changing this forces a new resource to be created.",
+				Description: "Name of the user. Changing this forces a new resource to be created. **Note**: Renaming users in place is not supported; the user cannot be replaced while it still owns repositories.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+// usersDataSource is the data source implementation.
+type usersDataSource struct {
+	client *forgejo.Client
+}
+
+// usersDataSourceModel maps the data source schema data.
+type usersDataSourceModel struct {
+	SourceID      types.Int64 `tfsdk:"source_id"`
+	Active        types.Bool  `tfsdk:"active"`
+	Admin         types.Bool  `tfsdk:"admin"`
+	Restricted    types.Bool  `tfsdk:"restricted"`
+	ProhibitLogin types.Bool  `tfsdk:"prohibit_login"`
+	Users         types.List  `tfsdk:"users"`
+}
+
+// matches reports whether a user satisfies the filters.
+func (m *usersDataSourceModel) matches(usr *forgejo.User) bool {
+	if !m.SourceID.IsNull() && m.SourceID.ValueInt64() != usr.SourceID {
+		return false
+	}
+
+	filters := []struct {
+		filter types.Bool
+		value  bool
+	}{
+		{m.Active, usr.IsActive},
+		{m.Admin, usr.IsAdmin},
+		{m.Restricted, usr.Restricted},
+		{m.ProhibitLogin, usr.ProhibitLogin},
+	}
+	for _, f := range filters {
+		if !f.filter.IsNull() && f.filter.ValueBool() != f.value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Metadata returns the data source type name.
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source.
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Forgejo users data source.
+
+Lists all users of the Forgejo instance. Filters are optional and combined; omitted filters match every user.
+
+**Note**: This data source requires administrative privileges!`,
+
+		Attributes: map[string]schema.Attribute{
+			"source_id": schema.Int64Attribute{
+				Description: "Only return users of the authentication source (login type) with this numeric identifier. Local users have the source 0.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Only return active (true) or inactive (false) users.",
+				Optional:    true,
+			},
+			"admin": schema.BoolAttribute{
+				Description: "Only return administrators (true) or regular users (false).",
+				Optional:    true,
+			},
+			"restricted": schema.BoolAttribute{
+				Description: "Only return restricted (true) or unrestricted (false) users.",
+				Optional:    true,
+			},
+			"prohibit_login": schema.BoolAttribute{
+				Description: "Only return users whose logins are prohibited (true) or allowed (false).",
+				Optional:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userDataSourceAttributes(),
+				},
+				Description: "Users matching the filters, sorted by login.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forgejo.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *forgejo.Client, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData,
+			),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer un(trace(ctx, "Read users data source"))
+
+	var data usersDataSourceModel
+
+	// Read Terraform configuration data into model
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use Forgejo client to list users
+	usrs, diags := listUsers(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Filter users and sort them by login for a stable order
+	usrs = slices.DeleteFunc(usrs, func(usr *forgejo.User) bool {
+		return !data.matches(usr)
+	})
+	slices.SortFunc(usrs, func(a, b *forgejo.User) int {
+		return strings.Compare(a.UserName, b.UserName)
+	})
+
+	// Map response body to model
+	users := make([]userDataSourceModel, len(usrs))
+	for i, usr := range usrs {
+		users[i].from(usr)
+	}
+	data.Users, diags = types.ListValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: userDataSourceModel{}.attributeTypes()},
+		users,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// NewUsersDataSource is a helper function to simplify the provider implementation.
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// listUsers is a helper function to list all users of the instance.
+func listUsers(ctx context.Context, client *forgejo.Client) ([]*forgejo.User, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "List users")
+
+	// Use Forgejo client to list users
+	usrs, res, err := listAllPages(func(opts forgejo.ListOptions) ([]*forgejo.User, *forgejo.Response, error) {
+		return client.AdminListUsers(forgejo.AdminListUsersOptions{
+			ListOptions: opts,
+		})
+	})
+	if err == nil {
+		return usrs, diags
+	}
+
+	// Handle errors
+	var msg string
+	if res == nil {
+		msg = fmt.Sprintf("Unknown error with nil response: %s", err)
+	} else {
+		tflog.Error(ctx, "Error", map[string]any{
+			"status": res.Status,
+		})
+
+		switch res.StatusCode {
+		case 403:
+			msg = fmt.Sprintf("Listing users forbidden (administrative privileges required): %s", err)
+		default:
+			msg = fmt.Sprintf(
+				"Unknown error (status %d): %s",
+				res.StatusCode,
+				err,
+			)
+		}
+	}
+	diags.AddError("Unable to list users", msg)
+
+	return nil, diags
+}
@@ -0,0 +1,66 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestAccUsersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+resource "forgejo_user" "restricted" {
+	login      = "tftest_restricted"
+	email      = "tftest_restricted@localhost.localdomain"
+	password   = "P@s$w0rd!"
+	restricted = true
+}
+resource "forgejo_user" "unrestricted" {
+	login    = "tftest_unrestricted"
+	email    = "tftest_unrestricted@localhost.localdomain"
+	password = "P@s$w0rd!"
+}
+data "forgejo_users" "all" {
+	depends_on = [forgejo_user.restricted, forgejo_user.unrestricted]
+}
+data "forgejo_users" "restricted" {
+	restricted = true
+	depends_on = [forgejo_user.restricted, forgejo_user.unrestricted]
+}
+data "forgejo_users" "admin" {
+	admin      = true
+	active     = true
+	source_id  = 0
+	depends_on = [forgejo_user.restricted, forgejo_user.unrestricted]
+}
+output "all" {
+	value = [for u in data.forgejo_users.all.users : u.login if startswith(u.login, "tftest_")]
+}
+output "restricted" {
+	value = [for u in data.forgejo_users.restricted.users : u.login if startswith(u.login, "tftest_")]
+}
+output "admin" {
+	value = [for u in data.forgejo_users.admin.users : u.login if u.login == "` + forgejoTestUser + `"]
+}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("data.forgejo_users.all", plancheck.ResourceActionRead),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("all", knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("tftest_restricted"), knownvalue.StringExact("tftest_unrestricted")})),
+					statecheck.ExpectKnownOutputValue("restricted", knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("tftest_restricted")})),
+					statecheck.ExpectKnownOutputValue("admin", knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact(forgejoTestUser)})),
+				},
+			},
+		},
+	})
+}